@@ -0,0 +1,224 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/miromax42/indicator/v2/helper"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// DefaultBoltRepositoryTimeout is the default duration to wait for the database file lock.
+	DefaultBoltRepositoryTimeout = time.Second
+)
+
+// boltSnapshotPrices is the number of prices and volume encoded for each snapshot.
+const boltSnapshotPrices = 5
+
+// BoltRepository stores and retrieves asset snapshots using an embedded Bolt database in a
+// single file. Each asset is a bucket of snapshots keyed by their dates, which keeps the
+// snapshots sorted, hence GetSince and LastDate seek the requested snapshots directly
+// instead of reading the entire asset. The dates are stored with their time zone offsets and
+// nanoseconds.
+type BoltRepository struct {
+	// db is the Bolt database.
+	db *bolt.DB
+}
+
+// NewBoltRepository initializes a Bolt repository with the given database file, creating the
+// file if it does not exist. The repository should be closed once it is no longer needed.
+func NewBoltRepository(fileName string) (*BoltRepository, error) {
+	db, err := bolt.Open(fileName, 0o600, &bolt.Options{
+		Timeout: DefaultBoltRepositoryTimeout,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to open database: %w", err)
+	}
+
+	return &BoltRepository{
+		db: db,
+	}, nil
+}
+
+// Close closes the database.
+func (r *BoltRepository) Close() error {
+	return r.db.Close()
+}
+
+// Assets returns the names of all assets in the repository.
+func (r *BoltRepository) Assets() ([]string, error) {
+	assets := []string{}
+
+	err := r.db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, _ *bolt.Bucket) error {
+			assets = append(assets, string(name))
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return assets, nil
+}
+
+// Get attempts to return a channel of snapshots for the asset with the given name.
+func (r *BoltRepository) Get(name string) (<-chan *Snapshot, error) {
+	return r.read(name, func(cursor *bolt.Cursor) ([]byte, []byte) {
+		return cursor.First()
+	})
+}
+
+// GetSince attempts to return a channel of snapshots for the asset with the given name since the given date.
+func (r *BoltRepository) GetSince(name string, date time.Time) (<-chan *Snapshot, error) {
+	return r.read(name, func(cursor *bolt.Cursor) ([]byte, []byte) {
+		return cursor.Seek(encodeBoltKey(date))
+	})
+}
+
+// LastDate returns the date of the last snapshot for the asset with the given name.
+func (r *BoltRepository) LastDate(name string) (time.Time, error) {
+	var last time.Time
+
+	err := r.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(name))
+		if bucket == nil {
+			return ErrRepositoryAssetNotFound
+		}
+
+		_, value := bucket.Cursor().Last()
+		if value == nil {
+			return ErrRepositoryAssetEmpty
+		}
+
+		snapshot, err := decodeBoltSnapshot(value)
+		if err != nil {
+			return err
+		}
+
+		last = snapshot.Date
+
+		return nil
+	})
+
+	return last, err
+}
+
+// Append adds the given snapshows to the asset with the given name. The snapshots are
+// appended in a single transaction, and a snapshot replaces the one with the same date.
+func (r *BoltRepository) Append(name string, snapshots <-chan *Snapshot) error {
+	// Drain the remaining snapshots if the transaction fails early.
+	defer helper.Drain(snapshots)
+
+	return r.db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists([]byte(name))
+		if err != nil {
+			return err
+		}
+
+		for snapshot := range snapshots {
+			value, err := encodeBoltSnapshot(snapshot)
+			if err != nil {
+				return err
+			}
+
+			err = bucket.Put(encodeBoltKey(snapshot.Date), value)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// read reads the snapshots of the asset with the given name starting from the snapshot
+// the given seek function positions the cursor at.
+func (r *BoltRepository) read(name string, seek func(*bolt.Cursor) ([]byte, []byte)) (<-chan *Snapshot, error) {
+	var snapshots []*Snapshot
+
+	err := r.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(name))
+		if bucket == nil {
+			return ErrRepositoryAssetNotFound
+		}
+
+		cursor := bucket.Cursor()
+
+		for _, value := seek(cursor); value != nil; _, value = cursor.Next() {
+			snapshot, err := decodeBoltSnapshot(value)
+			if err != nil {
+				return err
+			}
+
+			snapshots = append(snapshots, snapshot)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.SliceToChan(snapshots), nil
+}
+
+// encodeBoltKey encodes the given date as a key that sorts in the chronological order.
+func encodeBoltKey(date time.Time) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(date.Unix())^(1<<63))
+
+	return key
+}
+
+// encodeBoltSnapshot encodes the given snapshot as the prices and the volume followed by
+// the date along with its time zone offset.
+func encodeBoltSnapshot(snapshot *Snapshot) ([]byte, error) {
+	date, err := snapshot.Date.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	value := make([]byte, 0, boltSnapshotPrices*8+len(date))
+
+	for _, price := range []float64{snapshot.Open, snapshot.High, snapshot.Low, snapshot.Close, snapshot.Volume} {
+		value = binary.BigEndian.AppendUint64(value, math.Float64bits(price))
+	}
+
+	return append(value, date...), nil
+}
+
+// decodeBoltSnapshot decodes the snapshot encoded by encodeBoltSnapshot.
+func decodeBoltSnapshot(value []byte) (*Snapshot, error) {
+	if len(value) < boltSnapshotPrices*8 {
+		return nil, errors.New("malformed snapshot")
+	}
+
+	prices := make([]float64, boltSnapshotPrices)
+	for i := range prices {
+		prices[i] = math.Float64frombits(binary.BigEndian.Uint64(value[i*8:]))
+	}
+
+	snapshot := &Snapshot{
+		Open:   prices[0],
+		High:   prices[1],
+		Low:    prices[2],
+		Close:  prices[3],
+		Volume: prices[4],
+	}
+
+	err := snapshot.Date.UnmarshalBinary(value[boltSnapshotPrices*8:])
+	if err != nil {
+		return nil, fmt.Errorf("malformed snapshot date: %w", err)
+	}
+
+	return snapshot, nil
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset_test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+)
+
+func newTestBoltRepository(t *testing.T) *asset.BoltRepository {
+	repository, err := asset.NewBoltRepository(filepath.Join(t.TempDir(), "assets.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		err := repository.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/repository/brk-b.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = repository.Append("brk-b", snapshots)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return repository
+}
+
+func TestBoltRepositoryAssets(t *testing.T) {
+	repository := newTestBoltRepository(t)
+
+	assets, err := repository.Assets()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(assets) != 1 || assets[0] != "brk-b" {
+		t.Fatalf("actual %v expected %v", assets, []string{"brk-b"})
+	}
+}
+
+func TestBoltRepositoryGet(t *testing.T) {
+	repository := newTestBoltRepository(t)
+
+	actual, err := repository.Get("brk-b")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/repository/brk-b.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = repository.Get("unknown")
+	if !errors.Is(err, asset.ErrRepositoryAssetNotFound) {
+		t.Fatalf("actual %v expected %v", err, asset.ErrRepositoryAssetNotFound)
+	}
+}
+
+func TestBoltRepositoryGetSince(t *testing.T) {
+	repository := newTestBoltRepository(t)
+
+	date := time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	actual, err := repository.GetSince("brk-b", date)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := asset.NewFileSystemRepository(repositoryBase).GetSince("brk-b", date)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBoltRepositoryLastDate(t *testing.T) {
+	repository := newTestBoltRepository(t)
+
+	actual, err := repository.LastDate("brk-b")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := time.Date(2023, 11, 29, 0, 0, 0, 0, time.UTC)
+	if !actual.Equal(expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+
+	_, err = repository.LastDate("unknown")
+	if !errors.Is(err, asset.ErrRepositoryAssetNotFound) {
+		t.Fatalf("actual %v expected %v", err, asset.ErrRepositoryAssetNotFound)
+	}
+}
+
+func TestBoltRepositoryAppendDeduplicates(t *testing.T) {
+	repository := newTestBoltRepository(t)
+
+	location := time.FixedZone("EST", -5*60*60)
+
+	snapshots := []*asset.Snapshot{
+		{Date: time.Date(2023, 11, 29, 0, 0, 0, 0, time.UTC), Close: 1},
+		{Date: time.Date(2023, 11, 30, 9, 30, 0, 0, location), Close: 2},
+		{Date: time.Date(2023, 11, 30, 9, 30, 0, 0, location), Close: 3},
+	}
+
+	err := repository.Append("brk-b", helper.SliceToChan(snapshots))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual, err := repository.GetSince("brk-b", snapshots[0].Date)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []*asset.Snapshot{snapshots[0], snapshots[2]}
+
+	actualSlice := helper.ChanToSlice(actual)
+	if len(actualSlice) != len(expected) {
+		t.Fatalf("actual %d expected %d", len(actualSlice), len(expected))
+	}
+
+	// The time zone offset is kept, but not its name.
+	for i, snapshot := range actualSlice {
+		if !snapshot.Date.Equal(expected[i].Date) || snapshot.Close != expected[i].Close {
+			t.Fatalf("actual %v expected %v", snapshot, expected[i])
+		}
+
+		_, actualOffset := snapshot.Date.Zone()
+		_, expectedOffset := expected[i].Date.Zone()
+
+		if actualOffset != expectedOffset {
+			t.Fatalf("actual %v expected %v", actualOffset, expectedOffset)
+		}
+	}
+}
@@ -16,7 +16,8 @@ import (
 )
 
 // FileSystemRepository stores and retrieves asset snapshots using
-// the local file system.
+// the local file system. The dates keep their time zone offsets,
+// but their times below a second are lost.
 type FileSystemRepository struct {
 	// base is the root directory where asset snapshots are stored.
 	base string
@@ -71,6 +72,22 @@ func (r *FileSystemRepository) GetSince(name string, date time.Time) (<-chan *Sn
 	return snapshots, nil
 }
 
+// GetSinceWithError attempts to return a channel of snapshots for the asset with the given name
+// since the given date, along with an errors channel delivering the error that ended the
+// snapshots early, such as a truncated or a malformed CSV file.
+func (r *FileSystemRepository) GetSinceWithError(name string, date time.Time) (<-chan *Snapshot, <-chan error, error) {
+	snapshots, errs, err := helper.ReadFromCsvFileWithError[Snapshot](r.getCsvFileName(name), true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snapshots = helper.Filter(snapshots, func(s *Snapshot) bool {
+		return s.Date.Equal(date) || s.Date.After(date)
+	})
+
+	return snapshots, errs, nil
+}
+
 // LastDate returns the date of the last snapshot for the asset with the given name.
 func (r *FileSystemRepository) LastDate(name string) (time.Time, error) {
 	var last time.Time
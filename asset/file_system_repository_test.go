@@ -91,6 +91,61 @@ func TestFileSystemRepositoryGetSinceNonExisting(t *testing.T) {
 	}
 }
 
+func TestFileSystemRepositoryGetSinceWithError(t *testing.T) {
+	repository := asset.NewFileSystemRepository(repositoryBase)
+
+	date := time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)
+	actual, errs, err := repository.GetSinceWithError("brk-b", date)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/since.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = <-errs
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFileSystemRepositoryGetSinceWithErrorTruncated(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/truncated")
+
+	date := time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC)
+	snapshots, errs, err := repository.GetSinceWithError("brk-b", date)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual := helper.ChanToSlice(snapshots)
+	if len(actual) != 2 {
+		t.Fatalf("actual %d snapshots expected 2", len(actual))
+	}
+
+	err = <-errs
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestFileSystemRepositoryGetSinceWithErrorNonExisting(t *testing.T) {
+	repository := asset.NewFileSystemRepository(repositoryBase)
+
+	date := time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC)
+	_, _, err := repository.GetSinceWithError("brk", date)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
 func TestFileSystemRepositoryLastDate(t *testing.T) {
 	expeted := time.Date(2023, 11, 29, 0, 0, 0, 0, time.UTC)
 
@@ -155,3 +210,39 @@ func TestFileSystemRepositoryAppend(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestFileSystemRepositoryAppendIntraday(t *testing.T) {
+	repository := asset.NewFileSystemRepository(repositoryBase)
+
+	start := time.Date(2024, 1, 2, 9, 30, 0, 0, time.FixedZone("EST", -5*60*60))
+
+	expected := []*asset.Snapshot{
+		{Date: start, Open: 10, High: 12, Low: 9, Close: 11, Volume: 100},
+		{Date: start.Add(5 * time.Minute), Open: 11, High: 13, Low: 10, Close: 12, Volume: 200},
+		{Date: start.Add(10 * time.Minute), Open: 12, High: 14, Low: 11, Close: 13, Volume: 300},
+	}
+
+	name := "test_file_system_repository_append_intraday"
+	defer os.Remove(path.Join(repositoryBase, fmt.Sprintf("%s.csv", name)))
+
+	err := repository.Append(name, helper.SliceToChan(expected))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual, err := repository.GetSince(name, start.Add(5*time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actualSlice := helper.ChanToSlice(actual)
+	if len(actualSlice) != 2 {
+		t.Fatalf("actual %d expected 2", len(actualSlice))
+	}
+
+	for i, snapshot := range actualSlice {
+		if !snapshot.Date.Equal(expected[i+1].Date) || snapshot.Close != expected[i+1].Close {
+			t.Fatalf("actual %v expected %v", snapshot, expected[i+1])
+		}
+	}
+}
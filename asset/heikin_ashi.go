@@ -0,0 +1,63 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset
+
+import (
+	"math"
+
+	"github.com/miromax42/indicator/v2/helper"
+)
+
+// HeikinAshi transforms the snapshots into Heikin-Ashi candles. Heikin-Ashi candles
+// average the price movements to filter the noise, making the trends easier to spot.
+// Each snapshot completes exactly one candle, keeping its date and volume.
+//
+//	Close = (Open + High + Low + Close) / 4
+//	Open = (Previous HA Open + Previous HA Close) / 2
+//	High = Max(High, HA Open, HA Close)
+//	Low = Min(Low, HA Open, HA Close)
+//
+// The open of the first candle is the average of the first open and close.
+//
+// Example:
+//
+//	candles := asset.Transform(snapshots, asset.NewHeikinAshi())
+type HeikinAshi struct{}
+
+// NewHeikinAshi function initializes a new Heikin-Ashi transformer instance.
+func NewHeikinAshi() *HeikinAshi {
+	return &HeikinAshi{}
+}
+
+// Name returns the name of the transformer.
+func (*HeikinAshi) Name() string {
+	return "Heikin-Ashi"
+}
+
+// Transform processes the provided snapshots and generates a Heikin-Ashi candle for each one of them.
+func (*HeikinAshi) Transform(snapshots <-chan *Snapshot) <-chan []*Snapshot {
+	var previous *Snapshot
+
+	return helper.Map(snapshots, func(snapshot *Snapshot) []*Snapshot {
+		candle := &Snapshot{
+			Date:   snapshot.Date,
+			Close:  (snapshot.Open + snapshot.High + snapshot.Low + snapshot.Close) / 4,
+			Volume: snapshot.Volume,
+		}
+
+		if previous == nil {
+			candle.Open = (snapshot.Open + snapshot.Close) / 2
+		} else {
+			candle.Open = (previous.Open + previous.Close) / 2
+		}
+
+		candle.High = math.Max(snapshot.High, math.Max(candle.Open, candle.Close))
+		candle.Low = math.Min(snapshot.Low, math.Min(candle.Open, candle.Close))
+
+		previous = candle
+
+		return []*Snapshot{candle}
+	})
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset_test
+
+import (
+	"testing"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+)
+
+func TestHeikinAshi(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/repository/brk-b.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/heikin_ashi.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual := asset.Transform(snapshots, asset.NewHeikinAshi())
+	actual = helper.Map(actual, roundSnapshot)
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// roundSnapshot rounds the prices and the volume of the given snapshot to two digits.
+func roundSnapshot(snapshot *asset.Snapshot) *asset.Snapshot {
+	return &asset.Snapshot{
+		Date:   snapshot.Date,
+		Open:   helper.RoundDigit(snapshot.Open, 2),
+		High:   helper.RoundDigit(snapshot.High, 2),
+		Low:    helper.RoundDigit(snapshot.Low, 2),
+		Close:  helper.RoundDigit(snapshot.Close, 2),
+		Volume: helper.RoundDigit(snapshot.Volume, 2),
+	}
+}
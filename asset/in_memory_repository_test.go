@@ -117,6 +117,42 @@ func TestInMemoryRepositoryGetSince(t *testing.T) {
 	}
 }
 
+func TestInMemoryRepositoryGetSinceWithError(t *testing.T) {
+	repository := asset.NewInMemoryRepository()
+
+	name := "A"
+	date := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	snapshots := []*asset.Snapshot{
+		{Date: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	err := repository.Append(name, helper.SliceToChan(snapshots))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual, errs, err := asset.GetSinceWithError(repository, name, date)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = helper.CheckEquals(actual, helper.SliceToChan(snapshots))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = <-errs
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err = asset.GetSinceWithError(repository, "B", date)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
 func TestInMemoryRepositoryLastDate(t *testing.T) {
 	repository := asset.NewInMemoryRepository()
 
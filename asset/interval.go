@@ -0,0 +1,124 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Interval is the duration of a single snapshot bar, such as one minute for intraday
+// bars or one day for daily bars.
+type Interval time.Duration
+
+const (
+	// OneMinute is the interval of one minute bars.
+	OneMinute = Interval(time.Minute)
+
+	// FiveMinutes is the interval of five minute bars.
+	FiveMinutes = 5 * OneMinute
+
+	// FifteenMinutes is the interval of fifteen minute bars.
+	FifteenMinutes = 15 * OneMinute
+
+	// ThirtyMinutes is the interval of thirty minute bars.
+	ThirtyMinutes = 30 * OneMinute
+
+	// OneHour is the interval of one hour bars.
+	OneHour = Interval(time.Hour)
+
+	// FourHours is the interval of four hour bars.
+	FourHours = 4 * OneHour
+
+	// OneDay is the interval of daily bars.
+	OneDay = 24 * OneHour
+
+	// OneWeek is the interval of weekly bars.
+	OneWeek = 7 * OneDay
+)
+
+// ParseInterval parses the given interval string, such as 1m, 5m, 1h, 1d, or 1w. Besides the
+// d and w units for days and weeks, the units supported by time.ParseDuration are accepted.
+func ParseInterval(s string) (Interval, error) {
+	units := map[string]Interval{
+		"d": OneDay,
+		"w": OneWeek,
+	}
+
+	for suffix, unit := range units {
+		value, found := strings.CutSuffix(s, suffix)
+		if !found {
+			continue
+		}
+
+		count, err := strconv.Atoi(value)
+		if err != nil || count <= 0 {
+			return 0, fmt.Errorf("invalid interval %q", s)
+		}
+
+		return Interval(count) * unit, nil
+	}
+
+	duration, err := time.ParseDuration(s)
+	if err != nil || duration <= 0 {
+		return 0, fmt.Errorf("invalid interval %q", s)
+	}
+
+	return Interval(duration), nil
+}
+
+// Duration returns the interval as a duration.
+func (i Interval) Duration() time.Duration {
+	return time.Duration(i)
+}
+
+// IsIntraday returns true if the interval is shorter than a day.
+func (i Interval) IsIntraday() bool {
+	return i > 0 && i < OneDay
+}
+
+// String returns the interval in its shortest form using a single unit, such as 5m or 1d.
+func (i Interval) String() string {
+	units := []struct {
+		suffix string
+		unit   Interval
+	}{
+		{"w", OneWeek},
+		{"d", OneDay},
+		{"h", OneHour},
+		{"m", OneMinute},
+		{"s", Interval(time.Second)},
+	}
+
+	for _, u := range units {
+		if i > 0 && i%u.unit == 0 {
+			return fmt.Sprintf("%d%s", i/u.unit, u.suffix)
+		}
+	}
+
+	return time.Duration(i).String()
+}
+
+// DetectInterval detects the interval of the given snapshots as the shortest time between two
+// consecutive snapshots. It returns zero if there are less than two snapshots.
+func DetectInterval(snapshots <-chan *Snapshot) Interval {
+	var interval Interval
+	var previous *Snapshot
+
+	for snapshot := range snapshots {
+		if previous != nil {
+			gap := Interval(snapshot.Date.Sub(previous.Date))
+			if gap > 0 && (interval == 0 || gap < interval) {
+				interval = gap
+			}
+		}
+
+		previous = snapshot
+	}
+
+	return interval
+}
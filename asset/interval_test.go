@@ -0,0 +1,107 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+)
+
+func TestParseInterval(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected asset.Interval
+	}{
+		{"1m", asset.OneMinute},
+		{"5m", asset.FiveMinutes},
+		{"90m", 3 * asset.ThirtyMinutes},
+		{"1h", asset.OneHour},
+		{"4h", asset.FourHours},
+		{"1d", asset.OneDay},
+		{"2w", 2 * asset.OneWeek},
+	}
+
+	for _, test := range tests {
+		actual, err := asset.ParseInterval(test.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if actual != test.expected {
+			t.Fatalf("actual %v expected %v", actual, test.expected)
+		}
+	}
+}
+
+func TestParseIntervalInvalid(t *testing.T) {
+	inputs := []string{"", "abc", "0m", "-1h", "xd", "0w"}
+
+	for _, input := range inputs {
+		_, err := asset.ParseInterval(input)
+		if err == nil {
+			t.Fatalf("expected error for %q", input)
+		}
+	}
+}
+
+func TestIntervalString(t *testing.T) {
+	tests := []struct {
+		input    asset.Interval
+		expected string
+	}{
+		{asset.OneMinute, "1m"},
+		{asset.FifteenMinutes, "15m"},
+		{3 * asset.ThirtyMinutes, "90m"},
+		{asset.FourHours, "4h"},
+		{asset.OneDay, "1d"},
+		{asset.OneWeek, "1w"},
+		{0, "0s"},
+	}
+
+	for _, test := range tests {
+		actual := test.input.String()
+		if actual != test.expected {
+			t.Fatalf("actual %v expected %v", actual, test.expected)
+		}
+	}
+}
+
+func TestIntervalIsIntraday(t *testing.T) {
+	if !asset.OneHour.IsIntraday() {
+		t.Fatal("expected one hour to be intraday")
+	}
+
+	if asset.OneDay.IsIntraday() {
+		t.Fatal("expected one day not to be intraday")
+	}
+}
+
+func TestDetectInterval(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/repository/brk-b.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual := asset.DetectInterval(snapshots)
+	if actual != asset.OneDay {
+		t.Fatalf("actual %v expected %v", actual, asset.OneDay)
+	}
+
+	start := time.Date(2024, 1, 2, 9, 30, 0, 0, time.UTC)
+
+	snapshots = helper.SliceToChan([]*asset.Snapshot{
+		{Date: start},
+		{Date: start.Add(5 * time.Minute)},
+		{Date: start.Add(15 * time.Minute)},
+	})
+
+	actual = asset.DetectInterval(snapshots)
+	if actual != asset.FiveMinutes {
+		t.Fatalf("actual %v expected %v", actual, asset.FiveMinutes)
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/miromax42/indicator/v2/helper"
+)
+
+// ParquetRepository stores and retrieves asset snapshots as Parquet files in the local file
+// system, one file for each asset. The snapshots are stored in row groups, and the date
+// statistics of the row groups are used to skip the ones before the requested date. The
+// dates are stored as UTC timestamps in milliseconds, hence their time zones and their times
+// below a millisecond are lost, and they are read back in UTC. The FileSystemRepository keeps
+// the time zones but drops the times below a second, and the BoltRepository keeps both.
+type ParquetRepository struct {
+	// base is the root directory where asset snapshots are stored.
+	base string
+}
+
+// NewParquetRepository initializes a Parquet repository with the given base directory.
+func NewParquetRepository(base string) *ParquetRepository {
+	return &ParquetRepository{
+		base: base,
+	}
+}
+
+// Assets returns the names of all assets in the repository.
+func (r *ParquetRepository) Assets() ([]string, error) {
+	files, err := os.ReadDir(r.base)
+	if err != nil {
+		return nil, err
+	}
+
+	var assets []string
+
+	suffix := ".parquet"
+
+	for _, file := range files {
+		name := file.Name()
+
+		if strings.HasSuffix(name, suffix) {
+			assets = append(assets, strings.TrimSuffix(name, suffix))
+		}
+	}
+
+	return assets, nil
+}
+
+// Get attempts to return a channel of snapshots for the asset with the given name.
+func (r *ParquetRepository) Get(name string) (<-chan *Snapshot, error) {
+	return r.GetSince(name, time.Time{})
+}
+
+// GetSince attempts to return a channel of snapshots for the asset with the given name since the given date.
+func (r *ParquetRepository) GetSince(name string, date time.Time) (<-chan *Snapshot, error) {
+	snapshots, errs, err := r.GetSinceWithError(name, date)
+	if err != nil {
+		return nil, err
+	}
+
+	go helper.Drain(errs)
+
+	return snapshots, nil
+}
+
+// GetSinceWithError attempts to return a channel of snapshots for the asset with the given name
+// since the given date, along with an errors channel delivering the error that ended the
+// snapshots early, such as a truncated or a malformed Parquet file.
+func (r *ParquetRepository) GetSinceWithError(name string, date time.Time) (<-chan *Snapshot, <-chan error, error) {
+	parquet, err := helper.NewParquet[Snapshot]()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snapshots, errs, err := parquet.ReadFromFileSince(r.getParquetFileName(name), "Date", date)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil, ErrRepositoryAssetNotFound
+	}
+
+	return snapshots, errs, err
+}
+
+// LastDate returns the date of the last snapshot for the asset with the given name.
+func (r *ParquetRepository) LastDate(name string) (time.Time, error) {
+	var last time.Time
+
+	parquet, err := helper.NewParquet[Snapshot]()
+	if err != nil {
+		return last, err
+	}
+
+	snapshot, err := parquet.ReadLastFromFile(r.getParquetFileName(name))
+	if errors.Is(err, fs.ErrNotExist) {
+		return last, ErrRepositoryAssetNotFound
+	}
+
+	if err != nil {
+		return last, err
+	}
+
+	if snapshot == nil {
+		return last, ErrRepositoryAssetEmpty
+	}
+
+	return snapshot.Date, nil
+}
+
+// Append adds the given snapshows to the asset with the given name.
+func (r *ParquetRepository) Append(name string, snapshots <-chan *Snapshot) error {
+	return helper.AppendOrWriteToParquetFile(r.getParquetFileName(name), snapshots)
+}
+
+// getParquetFileName gets the Parquet file name for the given asset name.
+func (r *ParquetRepository) getParquetFileName(name string) string {
+	return filepath.Join(r.base, fmt.Sprintf("%s.parquet", name))
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+)
+
+func newTestParquetRepository(t *testing.T) *asset.ParquetRepository {
+	repository := asset.NewParquetRepository(t.TempDir())
+
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/repository/brk-b.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = repository.Append("brk-b", snapshots)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return repository
+}
+
+func TestParquetRepositoryAssets(t *testing.T) {
+	repository := newTestParquetRepository(t)
+
+	assets, err := repository.Assets()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(assets) != 1 || assets[0] != "brk-b" {
+		t.Fatalf("actual %v expected %v", assets, []string{"brk-b"})
+	}
+}
+
+func TestParquetRepositoryAssetsNonExistingDirectory(t *testing.T) {
+	repository := asset.NewParquetRepository("testdata/non_existing")
+
+	_, err := repository.Assets()
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestParquetRepositoryGet(t *testing.T) {
+	repository := newTestParquetRepository(t)
+
+	actual, err := repository.Get("brk-b")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/repository/brk-b.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = repository.Get("unknown")
+	if !errors.Is(err, asset.ErrRepositoryAssetNotFound) {
+		t.Fatalf("actual %v expected %v", err, asset.ErrRepositoryAssetNotFound)
+	}
+}
+
+func TestParquetRepositoryGetSince(t *testing.T) {
+	repository := newTestParquetRepository(t)
+
+	date := time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	actual, errs, err := repository.GetSinceWithError("brk-b", date)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := asset.NewFileSystemRepository(repositoryBase).GetSince("brk-b", date)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = <-errs
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParquetRepositoryLastDate(t *testing.T) {
+	repository := newTestParquetRepository(t)
+
+	actual, err := repository.LastDate("brk-b")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := time.Date(2023, 11, 29, 0, 0, 0, 0, time.UTC)
+	if !actual.Equal(expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+
+	_, err = repository.LastDate("unknown")
+	if !errors.Is(err, asset.ErrRepositoryAssetNotFound) {
+		t.Fatalf("actual %v expected %v", err, asset.ErrRepositoryAssetNotFound)
+	}
+}
+
+func TestParquetRepositoryLastDateEmpty(t *testing.T) {
+	repository := asset.NewParquetRepository(t.TempDir())
+
+	err := repository.Append("empty", helper.SliceToChan([]*asset.Snapshot{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = repository.LastDate("empty")
+	if !errors.Is(err, asset.ErrRepositoryAssetEmpty) {
+		t.Fatalf("actual %v expected %v", err, asset.ErrRepositoryAssetEmpty)
+	}
+}
+
+func TestParquetRepositoryAppend(t *testing.T) {
+	repository := newTestParquetRepository(t)
+
+	snapshot := &asset.Snapshot{
+		Date:  time.Date(2023, 11, 30, 0, 0, 0, 0, time.UTC),
+		Open:  1,
+		High:  2,
+		Low:   3,
+		Close: 4,
+	}
+
+	err := repository.Append("brk-b", helper.SliceToChan([]*asset.Snapshot{snapshot}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual, err := repository.GetSince("brk-b", snapshot.Date)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = helper.CheckEquals(actual, helper.SliceToChan([]*asset.Snapshot{snapshot}))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParquetRepositoryDatePrecision(t *testing.T) {
+	repository := asset.NewParquetRepository(t.TempDir())
+
+	date := time.Date(2024, 1, 2, 9, 30, 15, 123456789, time.FixedZone("", 3*60*60))
+
+	err := repository.Append("test", helper.SliceToChan([]*asset.Snapshot{
+		{Date: date, Close: 10},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshots, err := repository.Get("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual := helper.ChanToSlice(snapshots)
+
+	// The time zone and the times below a millisecond are lost.
+	expected := date.Truncate(time.Millisecond).UTC()
+
+	if len(actual) != 1 || actual[0].Date != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/volatility"
+)
+
+// Renko transforms the snapshots into Renko bricks. Renko bricks only consider the price
+// movements, ignoring the time. A new brick is completed each time the closing moves the
+// brick size beyond the top or the bottom of the last brick. A single snapshot may complete
+// multiple bricks, or none at all.
+//
+// The brick size is either fixed, or it is the ATR at the snapshot, adapting the bricks to
+// the volatility. No bricks are completed until the ATR is available.
+//
+// Each brick has the date of the snapshot completing it, its open and close at the brick
+// boundaries, and the volume accumulated since the previous brick.
+//
+// Example:
+//
+//	bricks := asset.Transform(snapshots, asset.NewRenkoWithSize(5))
+type Renko struct {
+	// Size is the fixed brick size. It is used only if the ATR is not set.
+	Size float64
+
+	// Atr is the ATR used as the brick size.
+	Atr *volatility.Atr[float64]
+}
+
+// NewRenko function initializes a new Renko transformer instance using the ATR with
+// the default period as the brick size.
+func NewRenko() *Renko {
+	return NewRenkoWithAtrPeriod(volatility.DefaultAtrPeriod)
+}
+
+// NewRenkoWithAtrPeriod function initializes a new Renko transformer instance using
+// the ATR with the given period as the brick size.
+func NewRenkoWithAtrPeriod(period int) *Renko {
+	return &Renko{
+		Atr: volatility.NewAtrWithPeriod[float64](period),
+	}
+}
+
+// NewRenkoWithSize function initializes a new Renko transformer instance with the given fixed brick size.
+func NewRenkoWithSize(size float64) *Renko {
+	return &Renko{
+		Size: size,
+	}
+}
+
+// Name returns the name of the transformer.
+func (r *Renko) Name() string {
+	if r.Atr != nil {
+		return "Renko (ATR)"
+	}
+
+	return fmt.Sprintf("Renko (%.2f)", r.Size)
+}
+
+// Transform processes the provided snapshots and generates the Renko bricks completed by each one of them.
+func (r *Renko) Transform(snapshots <-chan *Snapshot) <-chan []*Snapshot {
+	snapshotsSplice := helper.Duplicate(snapshots, 2)
+	sizes := r.sizes(snapshotsSplice[1])
+
+	started := false
+	var top, bottom, volume float64
+
+	return helper.Operate(snapshotsSplice[0], sizes, func(snapshot *Snapshot, size float64) []*Snapshot {
+		if !started {
+			top = snapshot.Close
+			bottom = snapshot.Close
+			started = true
+		}
+
+		volume += snapshot.Volume
+		bricks := []*Snapshot{}
+
+		if size <= 0 {
+			return bricks
+		}
+
+		for snapshot.Close >= top+size {
+			bricks = append(bricks, newRenkoBrick(snapshot.Date, top, top+size))
+			bottom = top
+			top += size
+		}
+
+		for snapshot.Close <= bottom-size {
+			bricks = append(bricks, newRenkoBrick(snapshot.Date, bottom, bottom-size))
+			top = bottom
+			bottom -= size
+		}
+
+		if len(bricks) > 0 {
+			bricks[0].Volume = volume
+			volume = 0
+		}
+
+		return bricks
+	})
+}
+
+// sizes returns the brick size for each snapshot.
+func (r *Renko) sizes(snapshots <-chan *Snapshot) <-chan float64 {
+	if r.Atr == nil {
+		return helper.Map(snapshots, func(*Snapshot) float64 {
+			return r.Size
+		})
+	}
+
+	snapshotsSplice := helper.Duplicate(snapshots, 3)
+
+	atr := r.Atr.Compute(
+		SnapshotsAsHighs(snapshotsSplice[0]),
+		SnapshotsAsLows(snapshotsSplice[1]),
+		SnapshotsAsClosings(snapshotsSplice[2]),
+	)
+
+	// ATR starts only after the idle period.
+	return helper.Shift(atr, r.Atr.IdlePeriod(), 0)
+}
+
+// newRenkoBrick creates a new Renko brick with the given date, open, and close.
+func newRenkoBrick(date time.Time, open, closing float64) *Snapshot {
+	return &Snapshot{
+		Date:  date,
+		Open:  open,
+		High:  math.Max(open, closing),
+		Low:   math.Min(open, closing),
+		Close: closing,
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset_test
+
+import (
+	"testing"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+)
+
+func TestRenkoWithSize(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/repository/brk-b.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/renko.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual := asset.Transform(snapshots, asset.NewRenkoWithSize(5))
+	actual = helper.Map(actual, roundSnapshot)
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRenkoWithAtr(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/repository/brk-b.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/renko_atr.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual := asset.Transform(snapshots, asset.NewRenko())
+	actual = helper.Map(actual, roundSnapshot)
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRenkoName(t *testing.T) {
+	if name := asset.NewRenko().Name(); name != "Renko (ATR)" {
+		t.Fatalf("actual %v", name)
+	}
+
+	if name := asset.NewRenkoWithSize(5).Name(); name != "Renko (5.00)" {
+		t.Fatalf("actual %v", name)
+	}
+}
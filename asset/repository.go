@@ -37,3 +37,51 @@ type Repository interface {
 	// given name.
 	Append(name string, snapshots <-chan *Snapshot) error
 }
+
+// ErrorReportingRepository is implemented by the repositories that are able to report
+// the errors encountered while the snapshots are being delivered, such as a truncated
+// file or a malformed response, which would otherwise look identical to a complete read.
+type ErrorReportingRepository interface {
+	Repository
+
+	// GetSinceWithError attempts to return a channel of snapshots for the asset with
+	// the given name since the given date, along with an errors channel delivering
+	// the error that ended the snapshots early, if any. The errors channel is closed
+	// after the snapshots channel.
+	GetSinceWithError(name string, date time.Time) (<-chan *Snapshot, <-chan error, error)
+}
+
+// GetSinceWithError attempts to return a channel of snapshots for the asset with the given
+// name since the given date, along with an errors channel delivering the error that ended
+// the snapshots early. If the repository is not able to report such errors, the returned
+// errors channel is closed without any errors.
+//
+// Example:
+//
+//	snapshots, errs, err := asset.GetSinceWithError(repository, "brk-b", since)
+//	if err != nil {
+//		return err
+//	}
+//
+//	snapshotsSlice := helper.ChanToSlice(snapshots)
+//
+//	err = <-errs
+//	if err != nil {
+//		return err
+//	}
+func GetSinceWithError(repository Repository, name string, date time.Time) (<-chan *Snapshot, <-chan error, error) {
+	reporting, ok := repository.(ErrorReportingRepository)
+	if ok {
+		return reporting.GetSinceWithError(name, date)
+	}
+
+	snapshots, err := repository.GetSince(name, date)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	errs := make(chan error)
+	close(errs)
+
+	return snapshots, errs, nil
+}
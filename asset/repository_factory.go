@@ -17,6 +17,12 @@ const (
 
 	// TiingoRepositoryBuilderName is the name of the Tiingo repository builder.
 	TiingoRepositoryBuilderName = "tiingo"
+
+	// BoltRepositoryBuilderName is the name of the Bolt repository builder.
+	BoltRepositoryBuilderName = "bolt"
+
+	// ParquetRepositoryBuilderName is the name of the Parquet repository builder.
+	ParquetRepositoryBuilderName = "parquet"
 )
 
 // RepositoryBuilderFunc defines a function to build a new repository using the given configuration parameter.
@@ -27,6 +33,8 @@ var repositoryBuilders = map[string]RepositoryBuilderFunc{
 	InMemoryRepositoryBuilderName:   inMemoryRepositoryBuilder,
 	FileSystemRepositoryBuilderName: fileSystemRepositoryBuilder,
 	TiingoRepositoryBuilderName:     tiingoRepositoryBuilder,
+	BoltRepositoryBuilderName:       boltRepositoryBuilder,
+	ParquetRepositoryBuilderName:    parquetRepositoryBuilder,
 }
 
 // RegisterRepositoryBuilder registers the given builder.
@@ -58,3 +66,18 @@ func fileSystemRepositoryBuilder(config string) (Repository, error) {
 func tiingoRepositoryBuilder(config string) (Repository, error) {
 	return NewTiingoRepository(config), nil
 }
+
+// boltRepositoryBuilder builds a new Bolt repository instance.
+func boltRepositoryBuilder(config string) (Repository, error) {
+	repository, err := NewBoltRepository(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return repository, nil
+}
+
+// parquetRepositoryBuilder builds a new Parquet repository instance.
+func parquetRepositoryBuilder(config string) (Repository, error) {
+	return NewParquetRepository(config), nil
+}
@@ -5,6 +5,7 @@
 package asset_test
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/miromax42/indicator/v2/asset"
@@ -75,3 +76,32 @@ func TestNewTiingoRepository(t *testing.T) {
 		t.Fatalf("repository not correct type: %T", repository)
 	}
 }
+
+func TestNewBoltRepository(t *testing.T) {
+	repository, err := asset.NewRepository(asset.BoltRepositoryBuilderName, filepath.Join(t.TempDir(), "assets.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	boltRepository, ok := repository.(*asset.BoltRepository)
+	if !ok {
+		t.Fatalf("repository not correct type: %T", repository)
+	}
+
+	err = boltRepository.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNewParquetRepository(t *testing.T) {
+	repository, err := asset.NewRepository(asset.ParquetRepositoryBuilderName, t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, ok := repository.(*asset.ParquetRepository)
+	if !ok {
+		t.Fatalf("repository not correct type: %T", repository)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset
+
+import (
+	"errors"
+	"time"
+)
+
+// ResampledRepository wraps a repository and serves its snapshots resampled into a coarser
+// timeframe, such as weekly bars from a repository of daily snapshots. The repository is
+// read only, as the resampled bars cannot be appended to the wrapped repository.
+//
+// Example:
+//
+//	weekly := asset.NewResampledRepository(repository, asset.NewResampler(asset.OneWeek))
+type ResampledRepository struct {
+	// repository is the wrapped repository.
+	repository Repository
+
+	// Resampler is the resampler for the snapshots.
+	Resampler *Resampler
+}
+
+// NewResampledRepository initializes a resampled repository wrapping the given repository.
+func NewResampledRepository(repository Repository, resampler *Resampler) *ResampledRepository {
+	return &ResampledRepository{
+		repository: repository,
+		Resampler:  resampler,
+	}
+}
+
+// Assets returns the names of all assets in the repository.
+func (r *ResampledRepository) Assets() ([]string, error) {
+	return r.repository.Assets()
+}
+
+// Get attempts to return a channel of bars for the asset with the given name.
+func (r *ResampledRepository) Get(name string) (<-chan *Snapshot, error) {
+	snapshots, err := r.repository.Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Resampler.Resample(snapshots), nil
+}
+
+// GetSince attempts to return a channel of bars for the asset with the given name since the
+// start of the period the given date belongs to, hence the first bar is not partial.
+func (r *ResampledRepository) GetSince(name string, date time.Time) (<-chan *Snapshot, error) {
+	snapshots, err := r.repository.GetSince(name, r.Resampler.PeriodStart(date))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Resampler.Resample(snapshots), nil
+}
+
+// GetSinceWithError attempts to return a channel of bars for the asset with the given name
+// since the start of the period the given date belongs to, along with an errors channel
+// delivering the error that ended the snapshots of the wrapped repository early, if any.
+func (r *ResampledRepository) GetSinceWithError(name string, date time.Time) (<-chan *Snapshot, <-chan error, error) {
+	snapshots, errs, err := GetSinceWithError(r.repository, name, r.Resampler.PeriodStart(date))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r.Resampler.Resample(snapshots), errs, nil
+}
+
+// LastDate returns the date of the last bar for the asset with the given name.
+func (r *ResampledRepository) LastDate(name string) (time.Time, error) {
+	last, err := r.repository.LastDate(name)
+	if err != nil {
+		return last, err
+	}
+
+	if r.Resampler.Label == ResampleLabelStart {
+		return r.Resampler.PeriodStart(last), nil
+	}
+
+	return last, nil
+}
+
+// Append is not supported by the resampled repository.
+func (*ResampledRepository) Append(_ string, _ <-chan *Snapshot) error {
+	return errors.ErrUnsupported
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+)
+
+func TestResampledRepositoryGet(t *testing.T) {
+	repository := asset.NewResampledRepository(
+		asset.NewFileSystemRepository(repositoryBase),
+		asset.NewResampler(asset.OneWeek),
+	)
+
+	actual, err := repository.Get("brk-b")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/resampler_weekly.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = helper.CheckEquals(helper.Map(actual, roundSnapshot), expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestResampledRepositoryGetSince(t *testing.T) {
+	repository := asset.NewResampledRepository(
+		asset.NewFileSystemRepository(repositoryBase),
+		asset.NewMonthlyResampler(),
+	)
+
+	// The month is read from its start.
+	actual, errs, err := asset.GetSinceWithError(repository, "brk-b", time.Date(2023, 10, 15, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/resampler_monthly.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = helper.CheckEquals(helper.Map(actual, roundSnapshot), helper.Skip(expected, 11))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = <-errs
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestResampledRepositoryLastDate(t *testing.T) {
+	resampler := asset.NewMonthlyResampler()
+	repository := asset.NewResampledRepository(asset.NewFileSystemRepository(repositoryBase), resampler)
+
+	actual, err := repository.LastDate("brk-b")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := time.Date(2023, 11, 29, 0, 0, 0, 0, time.UTC)
+	if !actual.Equal(expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+
+	resampler.Label = asset.ResampleLabelStart
+
+	actual, err = repository.LastDate("brk-b")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected = time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)
+	if !actual.Equal(expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestResampledRepositoryAppend(t *testing.T) {
+	repository := asset.NewResampledRepository(asset.NewInMemoryRepository(), asset.NewMonthlyResampler())
+
+	err := repository.Append("A", helper.SliceToChan([]*asset.Snapshot{}))
+	if !errors.Is(err, errors.ErrUnsupported) {
+		t.Fatalf("actual %v expected %v", err, errors.ErrUnsupported)
+	}
+}
@@ -0,0 +1,246 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ResampleLabel defines how the resampled bars are dated.
+type ResampleLabel int
+
+const (
+	// ResampleLabelLast dates each bar by its last snapshot, such as the last trading day of the month.
+	ResampleLabelLast ResampleLabel = iota
+
+	// ResampleLabelStart dates each bar by the start of its period, such as the first day of the month.
+	ResampleLabelStart
+)
+
+const (
+	// DefaultResamplerWeekStart is the default first day of the weekly bars.
+	DefaultResamplerWeekStart = time.Monday
+)
+
+// Resampler aggregates the snapshots into bars of a coarser timeframe, such as daily
+// snapshots into weekly or monthly bars, or one minute snapshots into hourly bars.
+//
+//	Open = First Open
+//	High = Max(High)
+//	Low = Min(Low)
+//	Close = Last Close
+//	Volume = Sum(Volume)
+//
+// The periods follow the calendar in the given location. Daily periods start at
+// midnight, intraday periods are aligned to midnight, weekly periods start on the
+// week start day, and monthly periods end at the end of the month.
+//
+// Example:
+//
+//	weekly := asset.NewResampler(asset.OneWeek).Resample(snapshots)
+type Resampler struct {
+	// Interval is the length of the periods. Periods of whole days and weeks
+	// are aligned to the calendar.
+	Interval Interval
+
+	// Months is the number of calendar months in each period, such as 1 for
+	// monthly and 3 for quarterly bars. It takes precedence over the interval
+	// when it is positive.
+	Months int
+
+	// WeekStart is the first day of the weekly periods.
+	WeekStart time.Weekday
+
+	// Location is the time zone the periods are aligned in.
+	Location *time.Location
+
+	// Label defines how the bars are dated.
+	Label ResampleLabel
+}
+
+// NewResampler function initializes a new resampler instance with the given interval.
+func NewResampler(interval Interval) *Resampler {
+	return &Resampler{
+		Interval:  interval,
+		WeekStart: DefaultResamplerWeekStart,
+		Location:  time.UTC,
+		Label:     ResampleLabelLast,
+	}
+}
+
+// NewMonthlyResampler function initializes a new resampler instance for monthly bars.
+func NewMonthlyResampler() *Resampler {
+	return NewResamplerWithMonths(1)
+}
+
+// NewResamplerWithMonths function initializes a new resampler instance with the given number of months.
+func NewResamplerWithMonths(months int) *Resampler {
+	resampler := NewResampler(OneDay)
+	resampler.Months = months
+
+	return resampler
+}
+
+// ParseResampler function initializes a new resampler instance for the given timeframe, such
+// as 1h, 1d, 1w, or 1mo. The mo unit is used for the calendar months, and the remaining units
+// are parsed as an interval.
+func ParseResampler(timeframe string) (*Resampler, error) {
+	value, found := strings.CutSuffix(timeframe, "mo")
+	if found {
+		months, err := strconv.Atoi(value)
+		if err != nil || months <= 0 {
+			return nil, fmt.Errorf("invalid timeframe %q", timeframe)
+		}
+
+		return NewResamplerWithMonths(months), nil
+	}
+
+	interval, err := ParseInterval(timeframe)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timeframe %q", timeframe)
+	}
+
+	return NewResampler(interval), nil
+}
+
+// Name returns the name of the transformer.
+func (r *Resampler) Name() string {
+	return fmt.Sprintf("Resampler (%s)", r.Timeframe())
+}
+
+// Timeframe returns the timeframe of the bars, such as 1w or 1mo.
+func (r *Resampler) Timeframe() string {
+	if r.Months > 0 {
+		return fmt.Sprintf("%dmo", r.Months)
+	}
+
+	return r.Interval.String()
+}
+
+// Resample processes the provided snapshots and generates the resampled bars. The last bar
+// is generated once the snapshots end, even if its period is not over yet.
+func (r *Resampler) Resample(snapshots <-chan *Snapshot) <-chan *Snapshot {
+	result := make(chan *Snapshot)
+
+	go func() {
+		defer close(result)
+
+		for completed := range r.resample(snapshots, true) {
+			for _, bar := range completed {
+				result <- bar
+			}
+		}
+	}()
+
+	return result
+}
+
+// Transform processes the provided snapshots and generates, for each one of them, the bar
+// completed by it. A bar is completed by the first snapshot of the next period, hence the
+// last bar is not generated.
+func (r *Resampler) Transform(snapshots <-chan *Snapshot) <-chan []*Snapshot {
+	return r.resample(snapshots, false)
+}
+
+// PeriodStart returns the start of the period the given date belongs to.
+func (r *Resampler) PeriodStart(date time.Time) time.Time {
+	location := r.Location
+	if location == nil {
+		location = time.UTC
+	}
+
+	date = date.In(location)
+	year, month, day := date.Date()
+
+	if r.Months > 0 {
+		months := year*12 + int(month) - 1
+		months -= floorMod(months, r.Months)
+
+		return time.Date(months/12, time.Month(months%12+1), 1, 0, 0, 0, 0, location)
+	}
+
+	midnight := time.Date(year, month, day, 0, 0, 0, 0, location)
+
+	if r.Interval <= 0 {
+		return midnight
+	}
+
+	if r.Interval%OneDay != 0 {
+		elapsed := date.Sub(midnight)
+		return midnight.Add(elapsed - elapsed%r.Interval.Duration())
+	}
+
+	// Days are counted on the calendar from the Unix epoch, which is a Thursday.
+	days := int(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / (24 * 60 * 60))
+	anchor := 0
+
+	if r.Interval%OneWeek == 0 {
+		anchor = floorMod(int(r.WeekStart)-int(time.Thursday), 7)
+	}
+
+	days -= floorMod(days-anchor, int(r.Interval/OneDay))
+
+	return time.Date(1970, time.January, 1+days, 0, 0, 0, 0, location)
+}
+
+// resample aggregates the snapshots into bars, and generates, for each snapshot, the bar
+// completed by it. The last bar is generated after the snapshots end if requested.
+func (r *Resampler) resample(snapshots <-chan *Snapshot, last bool) <-chan []*Snapshot {
+	result := make(chan []*Snapshot)
+
+	go func() {
+		defer close(result)
+
+		var bar *Snapshot
+		var start time.Time
+
+		for snapshot := range snapshots {
+			completed := []*Snapshot{}
+			current := r.PeriodStart(snapshot.Date)
+
+			if bar != nil && !current.Equal(start) {
+				completed = append(completed, bar)
+				bar = nil
+			}
+
+			if bar == nil {
+				start = current
+				bar = &Snapshot{
+					Open: snapshot.Open,
+					High: snapshot.High,
+					Low:  snapshot.Low,
+				}
+			}
+
+			bar.High = math.Max(bar.High, snapshot.High)
+			bar.Low = math.Min(bar.Low, snapshot.Low)
+			bar.Close = snapshot.Close
+			bar.Volume += snapshot.Volume
+
+			if r.Label == ResampleLabelStart {
+				bar.Date = start
+			} else {
+				bar.Date = snapshot.Date
+			}
+
+			result <- completed
+		}
+
+		if last && bar != nil {
+			result <- []*Snapshot{bar}
+		}
+	}()
+
+	return result
+}
+
+// floorMod returns the modulus of the given numbers with the sign of the divisor.
+func floorMod(a, b int) int {
+	return ((a % b) + b) % b
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+)
+
+func testResampler(t *testing.T, resampler *asset.Resampler, fileName string) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/repository/brk-b.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := helper.ReadFromCsvFile[asset.Snapshot](fileName, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual := resampler.Resample(snapshots)
+	actual = helper.Map(actual, roundSnapshot)
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestResamplerWeekly(t *testing.T) {
+	testResampler(t, asset.NewResampler(asset.OneWeek), "testdata/resampler_weekly.csv")
+}
+
+func TestResamplerMonthly(t *testing.T) {
+	testResampler(t, asset.NewMonthlyResampler(), "testdata/resampler_monthly.csv")
+}
+
+func TestResamplerQuarterly(t *testing.T) {
+	testResampler(t, asset.NewResamplerWithMonths(3), "testdata/resampler_quarterly.csv")
+}
+
+func TestResamplerTransform(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/repository/brk-b.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/resampler_weekly.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The last week is not completed by any snapshot.
+	expectedSlice := helper.ChanToSlice(expected)
+	expectedSlice = expectedSlice[:len(expectedSlice)-1]
+
+	actual := asset.Transform(snapshots, asset.NewResampler(asset.OneWeek))
+	actual = helper.Map(actual, roundSnapshot)
+
+	err = helper.CheckEquals(actual, helper.SliceToChan(expectedSlice))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestResamplerIntraday(t *testing.T) {
+	location := time.FixedZone("EST", -5*60*60)
+	start := time.Date(2024, 1, 2, 9, 30, 0, 0, location)
+
+	snapshots := make([]*asset.Snapshot, 90)
+	for i := range snapshots {
+		snapshots[i] = &asset.Snapshot{
+			Date:   start.Add(time.Duration(i) * time.Minute),
+			Open:   float64(i),
+			High:   float64(i + 2),
+			Low:    float64(i - 1),
+			Close:  float64(i + 1),
+			Volume: 10,
+		}
+	}
+
+	resampler := asset.NewResampler(asset.OneHour)
+	resampler.Location = location
+	resampler.Label = asset.ResampleLabelStart
+
+	expected := []*asset.Snapshot{
+		{Date: time.Date(2024, 1, 2, 9, 0, 0, 0, location), Open: 0, High: 31, Low: -1, Close: 30, Volume: 300},
+		{Date: time.Date(2024, 1, 2, 10, 0, 0, 0, location), Open: 30, High: 91, Low: 29, Close: 90, Volume: 600},
+	}
+
+	actual := resampler.Resample(helper.SliceToChan(snapshots))
+
+	err := helper.CheckEquals(actual, helper.SliceToChan(expected))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestResamplerPeriodStart(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	weekly := asset.NewResampler(asset.OneWeek)
+	weekly.WeekStart = time.Sunday
+
+	// Wednesday starts the week on the previous Sunday.
+	actual := weekly.PeriodStart(time.Date(2024, 1, 3, 12, 0, 0, 0, time.UTC))
+	expected := time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	if !actual.Equal(expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+
+	// The last day of the month in New York is the first day of the next month in UTC.
+	monthly := asset.NewMonthlyResampler()
+	monthly.Location = newYork
+
+	actual = monthly.PeriodStart(time.Date(2024, 2, 1, 3, 0, 0, 0, time.UTC))
+	expected = time.Date(2024, 1, 1, 0, 0, 0, 0, newYork)
+
+	if !actual.Equal(expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestParseResampler(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"1h", "Resampler (1h)"},
+		{"1d", "Resampler (1d)"},
+		{"1w", "Resampler (1w)"},
+		{"1mo", "Resampler (1mo)"},
+		{"3mo", "Resampler (3mo)"},
+	}
+
+	for _, test := range tests {
+		resampler, err := asset.ParseResampler(test.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if resampler.Name() != test.expected {
+			t.Fatalf("actual %v expected %v", resampler.Name(), test.expected)
+		}
+	}
+
+	for _, input := range []string{"", "0mo", "xmo", "abc"} {
+		_, err := asset.ParseResampler(input)
+		if err == nil {
+			t.Fatalf("expected error for %q", input)
+		}
+	}
+}
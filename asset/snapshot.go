@@ -13,8 +13,10 @@ import (
 // Snapshot captures a single observation of an asset's price
 // at a specific moment.
 type Snapshot struct {
-	// Date represents the specific timestamp.
-	Date time.Time `format:"2006-01-02"`
+	// Date represents the specific timestamp. Daily snapshots are
+	// persisted as dates, and intraday snapshots are persisted as
+	// timestamps with their time zone offsets.
+	Date time.Time `format:"2006-01-02|2006-01-02T15:04:05Z07:00"`
 
 	// Open represents the opening price for the
 	// snapshot period.
@@ -82,14 +82,24 @@ func (s *Sync) Run(source, target Repository, defaultStartDate time.Time) error
 
 				s.Logger.Info("Syncing asset.", "asset", name, "start", lastDate.Format("2006-01-02"))
 
-				snapshots, err := source.GetSince(name, lastDate)
+				snapshots, errs, err := GetSinceWithError(source, name, lastDate)
 				if err != nil {
 					s.Logger.Error("GetSince failed.", "asset", name, "error", err)
 					hasErrors = true
 					continue
 				}
 
-				err = target.Append(name, snapshots)
+				// Read all snapshots first to avoid appending a partial read to the target.
+				snapshotsSlice := helper.ChanToSlice(snapshots)
+
+				err = <-errs
+				if err != nil {
+					s.Logger.Error("GetSince failed.", "asset", name, "error", err)
+					hasErrors = true
+					continue
+				}
+
+				err = target.Append(name, helper.SliceToChan(snapshotsSlice))
 				if err != nil {
 					s.Logger.Error("Append failed.", "asset", name, "error", err)
 					hasErrors = true
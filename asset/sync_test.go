@@ -160,3 +160,27 @@ func TestSyncFailingTargetAppend(t *testing.T) {
 		t.Fatal("expected error")
 	}
 }
+
+func TestSyncTruncatedSource(t *testing.T) {
+	name := "brk-b"
+
+	source := asset.NewFileSystemRepository("testdata/truncated")
+	target := asset.NewInMemoryRepository()
+
+	defaultStartDate := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	sync := asset.NewSync()
+	sync.Workers = 1
+	sync.Delay = 0
+	sync.Assets = []string{name}
+
+	err := sync.Run(source, target, defaultStartDate)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	_, err = target.Get(name)
+	if err == nil {
+		t.Fatal("expected partial read not to be appended")
+	}
+}
@@ -12,6 +12,8 @@ import (
 	"log/slog"
 	"net/http"
 	"time"
+
+	"github.com/miromax42/indicator/v2/helper"
 )
 
 // TiingoMeta is the response from the meta endpoint.
@@ -134,6 +136,24 @@ func (r *TiingoRepository) Get(name string) (<-chan *Snapshot, error) {
 
 // GetSince attempts to return a channel of snapshots for the asset with the given name since the given date.
 func (r *TiingoRepository) GetSince(name string, date time.Time) (<-chan *Snapshot, error) {
+	snapshots, errs, err := r.GetSinceWithError(name, date)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		for err := range errs {
+			r.Logger.Error("GetSince failed.", "error", err)
+		}
+	}()
+
+	return snapshots, nil
+}
+
+// GetSinceWithError attempts to return a channel of snapshots for the asset with the given name
+// since the given date, along with an errors channel delivering the error that ended the
+// snapshots early, such as a truncated or a malformed response.
+func (r *TiingoRepository) GetSinceWithError(name string, date time.Time) (<-chan *Snapshot, <-chan error, error) {
 	url := fmt.Sprintf("%s/tiingo/daily/%s/prices?startDate=%s&token=%s",
 		r.BaseURL,
 		name,
@@ -142,56 +162,34 @@ func (r *TiingoRepository) GetSince(name string, date time.Time) (<-chan *Snapsh
 
 	req, err := http.NewRequest(http.MethodGet, url, http.NoBody)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	res, err := r.client.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if res.StatusCode != 200 {
-		return nil, fmt.Errorf("request failed with %s", res.Status)
+		helper.CloseAndLogErrorWithLogger(res.Body, "Unable to close respose.", r.Logger)
+		return nil, nil, fmt.Errorf("request failed with %s", res.Status)
 	}
 
 	snapshots := make(chan *Snapshot)
+	errs := make(chan error, 1)
 
 	go func() {
+		defer close(errs)
 		defer close(snapshots)
+		defer helper.CloseAndLogErrorWithLogger(res.Body, "Unable to close respose.", r.Logger)
 
-		decoder := json.NewDecoder(res.Body)
-
-		_, err = decoder.Token()
+		err := r.decodeEndOfDays(res.Body, snapshots)
 		if err != nil {
-			r.Logger.Error("Unable to read token.", "error", err)
-			return
-		}
-
-		for decoder.More() {
-			var data TiingoEndOfDay
-
-			err = decoder.Decode(&data)
-			if err != nil {
-				r.Logger.Error("Unable to decode data.", "error", err)
-				break
-			}
-
-			snapshots <- data.ToSnapshot()
-		}
-
-		_, err = decoder.Token()
-		if err != nil {
-			r.Logger.Error("GetSince failed.", "error", err)
-			return
-		}
-
-		err = res.Body.Close()
-		if err != nil {
-			r.Logger.Error("Unable to close respose.", "error", err)
+			errs <- err
 		}
 	}()
 
-	return snapshots, nil
+	return snapshots, errs, nil
 }
 
 // LastDate returns the date of the last snapshot for the asset with the given name.
@@ -238,3 +236,33 @@ func (r *TiingoRepository) LastDate(name string) (time.Time, error) {
 func (*TiingoRepository) Append(_ string, _ <-chan *Snapshot) error {
 	return errors.ErrUnsupported
 }
+
+// decodeEndOfDays decodes the end-of-day array from the given reader and sends
+// the resulting snapshots to the given channel. It returns the error that ended
+// the decoding early, if any.
+func (*TiingoRepository) decodeEndOfDays(reader io.Reader, snapshots chan<- *Snapshot) error {
+	decoder := json.NewDecoder(reader)
+
+	_, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("unable to read token: %w", err)
+	}
+
+	for decoder.More() {
+		var data TiingoEndOfDay
+
+		err = decoder.Decode(&data)
+		if err != nil {
+			return fmt.Errorf("unable to decode data: %w", err)
+		}
+
+		snapshots <- data.ToSnapshot()
+	}
+
+	_, err = decoder.Token()
+	if err != nil {
+		return fmt.Errorf("unable to read token: %w", err)
+	}
+
+	return nil
+}
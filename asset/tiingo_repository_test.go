@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
 )
 
 func TestTiingoRepositoryAssets(t *testing.T) {
@@ -113,6 +114,44 @@ func TestTiingoRepositoryGetInvalid(t *testing.T) {
 	}
 }
 
+func TestTiingoRepositoryGetSinceWithErrorInvalid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `[{"adjClose":10},{"adjClose":`)
+	}))
+
+	repository := asset.NewTiingoRepository("1234")
+	repository.BaseURL = server.URL
+
+	snapshots, errs, err := repository.GetSinceWithError("A", time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual := helper.ChanToSlice(snapshots)
+	if len(actual) != 1 {
+		t.Fatalf("actual %d snapshots expected 1", len(actual))
+	}
+
+	err = <-errs
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestTiingoRepositoryGetSinceWithErrorFailedRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	repository := asset.NewTiingoRepository("1234")
+	repository.BaseURL = server.URL
+
+	_, _, err := repository.GetSinceWithError("A", time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
 func TestTiingoRepositoryLastDate(t *testing.T) {
 	meta := asset.TiingoMeta{
 		Ticker:       "A",
@@ -0,0 +1,39 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset
+
+// Transformer defines the interface for converting a stream of snapshots into a stream of
+// alternative snapshots, such as smoothed candles or price bricks.
+type Transformer interface {
+	// Name returns the name of the transformer.
+	Name() string
+
+	// Transform processes the provided snapshots and generates, for each one of them, the
+	// transformed snapshots completed by it. Depending on the transformer, a snapshot may
+	// complete none, one, or multiple transformed snapshots.
+	Transform(snapshots <-chan *Snapshot) <-chan []*Snapshot
+}
+
+// Transform converts the provided snapshots using the given transformer and returns the
+// transformed snapshots as a single stream.
+//
+// Example:
+//
+//	candles := asset.Transform(snapshots, asset.NewHeikinAshi())
+func Transform(snapshots <-chan *Snapshot, transformer Transformer) <-chan *Snapshot {
+	result := make(chan *Snapshot)
+
+	go func() {
+		defer close(result)
+
+		for transformed := range transformer.Transform(snapshots) {
+			for _, snapshot := range transformed {
+				result <- snapshot
+			}
+		}
+	}()
+
+	return result
+}
@@ -59,6 +59,30 @@ type Backtest struct {
 	// LastDays is the number of days backtest should go back.
 	LastDays int
 
+	// LastDuration is the duration backtest should go back. It takes precedence
+	// over the last days when it is positive, such as for intraday bars.
+	LastDuration time.Duration
+
+	// LastBars is the number of most recent bars to backtest. It takes precedence over
+	// the last days and the last duration when it is positive. All bars since the
+	// lookback date are used when it is not positive.
+	LastBars int
+
+	// Resampler resamples the snapshots into a coarser timeframe, such as weekly
+	// bars, before the strategies are applied when set.
+	Resampler *asset.Resampler
+
+	// Costs is the transaction costs applied to the outcomes.
+	Costs *strategy.Costs
+
+	// Splitter enables the walk forward mode when set. Each asset is split into
+	// in-sample and out-of-sample windows, and the strategies are reported for
+	// each window as "<asset> (In-Sample <n>)" and "<asset> (Out-of-Sample <n>)",
+	// and for the out-of-sample windows combined as "<asset> (Out-of-Sample)".
+	// Unless the last bars are set, the lookback is raised to the minimum number
+	// of snapshots the splitter needs for a single window.
+	Splitter Splitter
+
 	// Logger is the slog logger instance.
 	Logger *slog.Logger
 }
@@ -72,6 +96,7 @@ func NewBacktest(repository asset.Repository, report Report) *Backtest {
 		Strategies: []strategy.Strategy{},
 		Workers:    DefaultBacktestWorkers,
 		LastDays:   DefaultLastDays,
+		Costs:      strategy.NewCosts(),
 		Logger:     slog.Default(),
 	}
 }
@@ -131,18 +156,38 @@ func (b *Backtest) Run() error {
 func (b *Backtest) worker(names <-chan string, wg *sync.WaitGroup) {
 	defer wg.Done()
 
-	since := time.Now().AddDate(0, 0, -b.LastDays)
+	since := lookbackSince(time.Now(), b.LastDays, b.LastDuration, b.LastBars)
+
+	// Fetch the whole first period, as resampling a partial one would produce a partial bar.
+	if b.Resampler != nil {
+		since = b.Resampler.PeriodStart(since)
+	}
 
 	for name := range names {
 		b.Logger.Info("Backtesting started.", "asset", name)
-		snapshots, err := b.repository.GetSince(name, since)
+		snapshotsSlice, err := b.loadSnapshots(name, since)
 		if err != nil {
 			b.Logger.Error("Unable to retrieve snapshots.", "asset", name, "error", err)
 			continue
 		}
 
-		// We don't expect the snapshots to be a stream during backtesting.
-		snapshotsSlice := helper.ChanToSlice(snapshots)
+		snapshotsSlice = lookbackBars(snapshotsSlice, b.LastBars)
+
+		// Raise the lookback to the snapshots needed by the splitter for at least one window.
+		if b.Splitter != nil && b.LastBars <= 0 && len(snapshotsSlice) < b.Splitter.MinSnapshots() {
+			snapshotsSlice, err = b.loadSnapshots(name, time.Time{})
+			if err != nil {
+				b.Logger.Error("Unable to retrieve snapshots.", "asset", name, "error", err)
+				continue
+			}
+
+			snapshotsSlice = lookbackBars(snapshotsSlice, b.Splitter.MinSnapshots())
+		}
+
+		if b.Splitter != nil {
+			b.walkForward(name, snapshotsSlice)
+			continue
+		}
 
 		// Backtesting asset has begun.
 		err = b.report.AssetBegin(name, b.Strategies)
@@ -153,10 +198,10 @@ func (b *Backtest) worker(names <-chan string, wg *sync.WaitGroup) {
 
 		// Backtest strategies on the given asset.
 		for _, currentStrategy := range b.Strategies {
-			snapshotsSplice := helper.Duplicate(helper.SliceToChan(snapshotsSlice), 2)
-
-			actions, outcomes := strategy.ComputeWithOutcome(currentStrategy, snapshotsSplice[0])
-			err = b.report.Write(name, currentStrategy, snapshotsSplice[1], actions, outcomes)
+			// The report gets its own snapshots, as reading them in lockstep with the actions
+			// would block the strategies reading ahead of their actions.
+			actions, outcomes, costs := strategy.ComputeWithOutcomeAndCosts(currentStrategy, helper.SliceToChan(snapshotsSlice), b.Costs)
+			err = WriteWithCosts(b.report, name, currentStrategy, helper.SliceToChan(snapshotsSlice), actions, outcomes, costs)
 			if err != nil {
 				b.Logger.Error("Unable to write report.", "asset", name, "error", err)
 			}
@@ -169,3 +214,27 @@ func (b *Backtest) worker(names <-chan string, wg *sync.WaitGroup) {
 		}
 	}
 }
+
+// loadSnapshots retrieves the snapshots of the asset with the given name since the given
+// date, and resamples them when a resampler is set. A partial read fails the asset instead
+// of backtesting on it.
+func (b *Backtest) loadSnapshots(name string, since time.Time) ([]*asset.Snapshot, error) {
+	snapshots, errs, err := asset.GetSinceWithError(b.repository, name, since)
+	if err != nil {
+		return nil, err
+	}
+
+	// We don't expect the snapshots to be a stream during backtesting.
+	snapshotsSlice := helper.ChanToSlice(snapshots)
+
+	err = <-errs
+	if err != nil {
+		return nil, err
+	}
+
+	if b.Resampler != nil {
+		snapshotsSlice = helper.ChanToSlice(b.Resampler.Resample(helper.SliceToChan(snapshotsSlice)))
+	}
+
+	return snapshotsSlice, nil
+}
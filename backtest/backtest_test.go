@@ -6,10 +6,15 @@ package backtest_test
 
 import (
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/miromax42/indicator/v2/asset"
 	"github.com/miromax42/indicator/v2/backtest"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/strategy"
 	"github.com/miromax42/indicator/v2/strategy/trend"
 )
 
@@ -72,3 +77,146 @@ func TestBacktestNonExistingAsset(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestBacktestTruncatedAsset(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/truncated")
+
+	dataReport := backtest.NewDataReport()
+	backtest := backtest.NewBacktest(repository, dataReport)
+	backtest.Names = append(backtest.Names, "brk-b")
+
+	err := backtest.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, ok := dataReport.Results["brk-b"]
+	if ok {
+		t.Fatal("expected truncated asset to be skipped")
+	}
+}
+
+func TestBacktestCosts(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/repository")
+
+	dataReport := backtest.NewDataReport()
+	backtest := backtest.NewBacktest(repository, dataReport)
+	backtest.Names = append(backtest.Names, "brk-b")
+	backtest.LastDays = 10000
+	backtest.Costs = strategy.NewCostsWith(
+		strategy.NewPercentageCommissionWith(0.1),
+		strategy.NewFixedSlippageWith(5),
+	)
+
+	err := backtest.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, ok := dataReport.Results["brk-b"]
+	if !ok || len(results) != 1 {
+		t.Fatal("asset result not found")
+	}
+
+	if results[0].Costs <= 0 {
+		t.Fatalf("actual %v expected positive costs", results[0].Costs)
+	}
+}
+
+func TestBacktestHTMLReportDateFormat(t *testing.T) {
+	hourly := asset.NewInMemoryRepository()
+	date := time.Date(2023, 11, 28, 10, 0, 0, 0, time.UTC)
+	snapshots := make([]*asset.Snapshot, 10)
+
+	for i := range snapshots {
+		snapshots[i] = &asset.Snapshot{
+			Date:  date.Add(time.Duration(i) * time.Hour),
+			Open:  10,
+			High:  11,
+			Low:   9,
+			Close: 10,
+		}
+	}
+
+	err := hourly.Append("brk-b", helper.SliceToChan(snapshots))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		repository asset.Repository
+		expected   string
+		unexpected string
+	}{
+		{asset.NewFileSystemRepository("testdata/repository"), `new Date("2023-11-29")`, "T00:00:00Z"},
+		{hourly, `new Date("2023-11-28T19:00:00Z")`, `new Date("2023-11-28")`},
+	}
+
+	for _, test := range tests {
+		outputDir, err := os.MkdirTemp("", "backtest")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		defer os.RemoveAll(outputDir)
+
+		buyAndHold := strategy.NewBuyAndHoldStrategy()
+
+		backtester := backtest.NewBacktest(test.repository, backtest.NewHTMLReport(outputDir))
+		backtester.Names = append(backtester.Names, "brk-b")
+		backtester.Strategies = append(backtester.Strategies, buyAndHold)
+		backtester.LastBars = 100
+
+		err = backtester.Run()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		content, err := os.ReadFile(filepath.Join(outputDir, "brk-b - "+buyAndHold.Name()+".html"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !strings.Contains(string(content), test.expected) || strings.Contains(string(content), test.unexpected) {
+			t.Fatalf("expected %v without %v in the strategy report", test.expected, test.unexpected)
+		}
+	}
+}
+
+func TestBacktestHTMLReportCosts(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/repository")
+
+	outputDir, err := os.MkdirTemp("", "backtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(outputDir)
+
+	macd := trend.NewMacdStrategy()
+
+	htmlReport := backtest.NewHTMLReport(outputDir)
+	backtest := backtest.NewBacktest(repository, htmlReport)
+	backtest.Names = append(backtest.Names, "brk-b")
+	backtest.Strategies = append(backtest.Strategies, macd)
+	backtest.LastDays = 10000
+	backtest.Costs = strategy.NewCostsWith(
+		strategy.NewPercentageCommissionWith(0.1),
+		strategy.NewFixedSlippageWith(5),
+	)
+
+	err = backtest.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The strategy report plots the outcomes after the costs along with the costs.
+	content, err := os.ReadFile(filepath.Join(outputDir, "brk-b - "+macd.Name()+".html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(content), "Costs") {
+		t.Fatal("expected costs in the strategy report")
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package backtest
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/metrics"
+	"github.com/miromax42/indicator/v2/strategy"
+)
+
+// CSVReport is the backtest CSV report. It writes the strategy results of each asset to
+// a CSV file named after the asset, in the order the strategies complete. When enabled,
+// the per-bar actions and outcomes of each strategy are written to a separate CSV file
+// named after the asset and the strategy. The results and the bars are written as they
+// arrive, keeping only the equity curve in memory for the metrics.
+type CSVReport struct {
+	// outputDir is the output directory for the generated reports.
+	outputDir string
+
+	// files writes the strategy results and the bars of the assets.
+	files *fileReport
+
+	// WriteBars indicates whether the per-bar actions and outcomes should be written.
+	WriteBars bool
+
+	// Calculator is the risk and performance metrics calculator. The periods per year
+	// are derived from the interval detected from the snapshots.
+	Calculator *metrics.Calculator
+}
+
+// NewCSVReport initializes a new CSV report instance.
+func NewCSVReport(outputDir string) *CSVReport {
+	return &CSVReport{
+		outputDir:  outputDir,
+		files:      newFileReport(outputDir, ".csv", writeCsvReportFile[FileStrategyResult], writeCsvReportFile[FileBar]),
+		WriteBars:  DefaultWriteBars,
+		Calculator: metrics.NewCalculator(),
+	}
+}
+
+// Begin is called when the backtest begins.
+func (c *CSVReport) Begin(_ []string, _ []strategy.Strategy) error {
+	// Make sure that output directory exists.
+	err := os.MkdirAll(c.outputDir, 0o700)
+	if err != nil {
+		return fmt.Errorf("unable to make the output directory: %w", err)
+	}
+
+	return nil
+}
+
+// AssetBegin is called when backtesting for the given asset begins.
+func (c *CSVReport) AssetBegin(name string, _ []strategy.Strategy) error {
+	return c.files.begin(name)
+}
+
+// Write writes the given strategy actions and outomes to the report.
+func (c *CSVReport) Write(assetName string, currentStrategy strategy.Strategy, snapshots <-chan *asset.Snapshot, actions <-chan strategy.Action, outcomes <-chan float64) error {
+	outcomes, costs := withoutCosts(outcomes)
+	return c.WriteWithCosts(assetName, currentStrategy, snapshots, actions, outcomes, costs)
+}
+
+// WriteWithCosts writes the given strategy actions, outcomes, and cumulative transaction costs to the report.
+func (c *CSVReport) WriteWithCosts(assetName string, currentStrategy strategy.Strategy, snapshots <-chan *asset.Snapshot, actions <-chan strategy.Action, outcomes <-chan float64, costs <-chan float64) error {
+	return c.files.write(assetName, currentStrategy, snapshots, actions, outcomes, costs, c.WriteBars, c.Calculator)
+}
+
+// AssetEnd is called when backtesting for the given asset ends.
+func (c *CSVReport) AssetEnd(name string) error {
+	return c.files.end(name)
+}
+
+// End is called when the backtest ends.
+func (*CSVReport) End() error {
+	return nil
+}
+
+// writeCsvReportFile writes the given rows to the CSV file with the given name,
+// replacing the existing file.
+func writeCsvReportFile[T any](fileName string, rows <-chan *T) error {
+	defer helper.Drain(rows)
+
+	csv, err := helper.NewCsv[T](true)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(fileName)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return csv.WriteToFile(fileName, rows)
+}
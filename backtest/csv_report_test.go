@@ -0,0 +1,71 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package backtest_test
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/backtest"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/strategy"
+	"github.com/miromax42/indicator/v2/strategy/trend"
+)
+
+func TestCSVReport(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/repository")
+
+	outputDir, err := os.MkdirTemp("", "backtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(outputDir)
+
+	csvReport := backtest.NewCSVReport(outputDir)
+	csvReport.WriteBars = true
+
+	buyAndHold := strategy.NewBuyAndHoldStrategy()
+	macd := trend.NewMacdStrategy()
+
+	backtester := backtest.NewBacktest(repository, csvReport)
+	backtester.Names = append(backtester.Names, "brk-b")
+	backtester.Strategies = append(backtester.Strategies, macd, buyAndHold)
+	backtester.LastDays = 10000
+
+	err = backtester.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := helper.ReadFromCsvFile[backtest.FileStrategyResult](filepath.Join(outputDir, "brk-b.csv"), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	names := helper.ChanToSlice(helper.Map(results, func(r *backtest.FileStrategyResult) string {
+		return r.Strategy
+	}))
+
+	// Results are written in the order the strategies complete.
+	slices.Sort(names)
+
+	if len(names) != 2 || names[0] != buyAndHold.Name() || names[1] != macd.Name() {
+		t.Fatalf("actual %v expected %v", names, []string{buyAndHold.Name(), macd.Name()})
+	}
+
+	bars, err := helper.ReadFromCsvFile[backtest.FileBar](filepath.Join(outputDir, "brk-b - "+macd.Name()+".csv"), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual := len(helper.ChanToSlice(bars))
+	if actual != 251 {
+		t.Fatalf("actual %v expected %v", actual, 251)
+	}
+}
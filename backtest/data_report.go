@@ -9,6 +9,7 @@ import (
 
 	"github.com/miromax42/indicator/v2/asset"
 	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/metrics"
 	"github.com/miromax42/indicator/v2/strategy"
 )
 
@@ -28,6 +29,12 @@ type DataStrategyResult struct {
 
 	// Transactions are the action recommendations.
 	Transactions []strategy.Action
+
+	// Costs is the transaction costs paid relative to the invested capital.
+	Costs float64
+
+	// Metrics is the risk and performance metrics of the strategy.
+	Metrics *metrics.Metrics
 }
 
 // DataReport is the bactest data report enablign programmatic access to the backtest results.
@@ -35,12 +42,17 @@ type DataReport struct {
 	// Results are the backtest results for the assets.
 	Results   map[string][]*DataStrategyResult
 	muResults sync.Mutex
+
+	// Calculator is the risk and performance metrics calculator. The periods per year
+	// are derived from the interval detected from the snapshots.
+	Calculator *metrics.Calculator
 }
 
 // NewDataReport initializes a new data report instance.
 func NewDataReport() *DataReport {
 	return &DataReport{
-		Results: make(map[string][]*DataStrategyResult),
+		Results:    make(map[string][]*DataStrategyResult),
+		Calculator: metrics.NewCalculator(),
 	}
 }
 
@@ -59,10 +71,29 @@ func (d *DataReport) AssetBegin(name string, strategies []strategy.Strategy) err
 
 // Write writes the given strategy actions and outomes to the report.
 func (d *DataReport) Write(assetName string, currentStrategy strategy.Strategy, snapshots <-chan *asset.Snapshot, actions <-chan strategy.Action, outcomes <-chan float64) error {
-	go helper.Drain(snapshots)
+	outcomes, costs := withoutCosts(outcomes)
+	return d.WriteWithCosts(assetName, currentStrategy, snapshots, actions, outcomes, costs)
+}
+
+// WriteWithCosts writes the given strategy actions, outcomes, and cumulative transaction costs to the report.
+func (d *DataReport) WriteWithCosts(assetName string, currentStrategy strategy.Strategy, snapshots <-chan *asset.Snapshot, actions <-chan strategy.Action, outcomes <-chan float64, costs <-chan float64) error {
+	intervals := make(chan asset.Interval, 1)
+	go func() {
+		intervals <- asset.DetectInterval(snapshots)
+	}()
+
+	outcomesSplice := helper.Duplicate(outcomes, 2)
+	actionsSplice := helper.Duplicate(actions, 2)
+
+	computed := make(chan *metrics.Metrics, 1)
+	go func() {
+		equities, actions := metrics.Collect(outcomesSplice[1], actionsSplice[1])
+		computed <- d.Calculator.ForInterval(<-intervals).ComputeFromEquities(equities, actions)
+	}()
 
-	outcome := helper.Last(outcomes, 1)
-	transactions := helper.ChanToSlice(actions)
+	outcome := helper.Last(outcomesSplice[0], 1)
+	cost := helper.Last(costs, 1)
+	transactions := helper.ChanToSlice(actionsSplice[0])
 
 	result := &DataStrategyResult{
 		Asset:        assetName,
@@ -70,6 +101,8 @@ func (d *DataReport) Write(assetName string, currentStrategy strategy.Strategy,
 		Outcome:      <-outcome * 100,
 		Action:       strategy.Hold,
 		Transactions: transactions,
+		Costs:        <-cost * 100,
+		Metrics:      <-computed,
 	}
 
 	d.muResults.Lock()
@@ -6,10 +6,12 @@ package backtest_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/miromax42/indicator/v2/asset"
 	"github.com/miromax42/indicator/v2/backtest"
 	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/metrics"
 	"github.com/miromax42/indicator/v2/strategy"
 )
 
@@ -76,3 +78,75 @@ func TestDataReport(t *testing.T) {
 		t.Fatalf("results count and strategies count are not the same, %d %d", len(results), len(strategies))
 	}
 }
+
+func TestDataReportMetrics(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/repository")
+
+	report := backtest.NewDataReport()
+	backtest := backtest.NewBacktest(repository, report)
+	backtest.Names = append(backtest.Names, "brk-b")
+	backtest.LastDays = 10000
+
+	err := backtest.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, ok := report.Results["brk-b"]
+	if !ok || len(results) != 1 {
+		t.Fatal("asset result not found")
+	}
+
+	metrics := results[0].Metrics
+	if metrics == nil {
+		t.Fatal("metrics not found")
+	}
+
+	if metrics.Trades != 1 || metrics.Exposure != 1 {
+		t.Fatalf("actual %v %v expected %v %v", metrics.Trades, metrics.Exposure, 1, 1)
+	}
+
+	if helper.RoundDigit(metrics.MaxDrawdown, 2) <= 0 {
+		t.Fatalf("actual %v expected positive drawdown", metrics.MaxDrawdown)
+	}
+}
+
+func TestDataReportIntradayMetrics(t *testing.T) {
+	start := time.Date(2024, 1, 2, 9, 30, 0, 0, time.UTC)
+	closings := []float64{10, 11, 10.5, 12, 11.5, 12.5}
+
+	snapshots := make([]*asset.Snapshot, len(closings))
+	for i, closing := range closings {
+		snapshots[i] = &asset.Snapshot{
+			Date:  start.Add(time.Duration(i) * asset.FiveMinutes.Duration()),
+			Close: closing,
+		}
+	}
+
+	buyAndHold := strategy.NewBuyAndHoldStrategy()
+	actions := helper.ChanToSlice(buyAndHold.Compute(helper.SliceToChan(snapshots)))
+	outcomes := helper.ChanToSlice(strategy.Outcome(helper.SliceToChan(closings), helper.SliceToChan(actions)))
+
+	report := backtest.NewDataReport()
+
+	err := report.AssetBegin("intraday", []strategy.Strategy{buyAndHold})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = report.Write("intraday", buyAndHold, helper.SliceToChan(snapshots), helper.SliceToChan(actions), helper.SliceToChan(outcomes))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := metrics.NewCalculator().ForInterval(asset.FiveMinutes).Compute(helper.SliceToChan(outcomes), helper.SliceToChan(actions))
+	actual := report.Results["intraday"][0].Metrics
+
+	if *actual != *expected {
+		t.Fatalf("actual %v expected %v", *actual, *expected)
+	}
+
+	if actual.Volatility == metrics.NewCalculator().Compute(helper.SliceToChan(outcomes), helper.SliceToChan(actions)).Volatility {
+		t.Fatal("expected intraday annualized volatility")
+	}
+}
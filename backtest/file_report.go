@@ -0,0 +1,372 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package backtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/metrics"
+	"github.com/miromax42/indicator/v2/strategy"
+)
+
+const (
+	// DefaultWriteBars is the default state of writing the per-bar actions and outcomes.
+	DefaultWriteBars = false
+)
+
+// FileStrategyResult is the result of a strategy for an asset, as written by the file reports.
+type FileStrategyResult struct {
+	// Asset is the asset name.
+	Asset string `json:"asset" header:"Asset"`
+
+	// Strategy is the strategy name.
+	Strategy string `json:"strategy" header:"Strategy"`
+
+	// Action is the final action recommended by the strategy.
+	Action strategy.Action `json:"action" header:"Action"`
+
+	// Since indicates how long the final action recommendation has been in effect.
+	Since int `json:"since" header:"Since"`
+
+	// Outcome is the strategy outcome percent.
+	Outcome float64 `json:"outcome" header:"Outcome"`
+
+	// Transactions is the number of transactions made by the strategy.
+	Transactions int `json:"transactions" header:"Transactions"`
+
+	// Costs is the transaction costs percent paid relative to the invested capital.
+	Costs float64 `json:"costs" header:"Costs"`
+
+	// Cagr is the compound annual growth rate.
+	Cagr float64 `json:"cagr" header:"Cagr"`
+
+	// Volatility is the annualized volatility of the returns.
+	Volatility float64 `json:"volatility" header:"Volatility"`
+
+	// Sharpe is the annualized Sharpe ratio.
+	Sharpe float64 `json:"sharpe" header:"Sharpe"`
+
+	// Sortino is the annualized Sortino ratio.
+	Sortino float64 `json:"sortino" header:"Sortino"`
+
+	// Calmar is the Calmar ratio.
+	Calmar float64 `json:"calmar" header:"Calmar"`
+
+	// MaxDrawdown is the maximum decline from a peak as a fraction of the peak.
+	MaxDrawdown float64 `json:"maxDrawdown" header:"MaxDrawdown"`
+
+	// MaxDrawdownDuration is the longest number of periods spent below a peak.
+	MaxDrawdownDuration int `json:"maxDrawdownDuration" header:"MaxDrawdownDuration"`
+
+	// WinRate is the fraction of the trades that are profitable.
+	WinRate float64 `json:"winRate" header:"WinRate"`
+
+	// ProfitFactor is the gross profit divided by the gross loss. It is positive
+	// infinity when there are winning trades but no losing trades.
+	ProfitFactor FileFloat `json:"profitFactor" header:"ProfitFactor"`
+
+	// AverageWin is the average return of the profitable trades.
+	AverageWin float64 `json:"averageWin" header:"AverageWin"`
+
+	// AverageLoss is the average return of the losing trades.
+	AverageLoss float64 `json:"averageLoss" header:"AverageLoss"`
+
+	// Exposure is the fraction of the periods spent in a long or a short position.
+	Exposure float64 `json:"exposure" header:"Exposure"`
+
+	// Trades is the number of trades.
+	Trades int `json:"trades" header:"Trades"`
+}
+
+// FileFloat is a float written to the JSON files as a number when it is finite, and as a
+// string, such as "+Inf", otherwise, as the JSON numbers can't represent infinity.
+type FileFloat float64
+
+// MarshalJSON encodes the float as a number when it is finite, and as a string otherwise.
+func (f FileFloat) MarshalJSON() ([]byte, error) {
+	value := float64(f)
+
+	if math.IsInf(value, 0) || math.IsNaN(value) {
+		return json.Marshal(strconv.FormatFloat(value, 'g', -1, 64))
+	}
+
+	return json.Marshal(value)
+}
+
+// UnmarshalJSON decodes the float from either a number or a string.
+func (f *FileFloat) UnmarshalJSON(data []byte) error {
+	var text string
+
+	err := json.Unmarshal(data, &text)
+	if err != nil {
+		var value float64
+
+		err = json.Unmarshal(data, &value)
+		if err != nil {
+			return err
+		}
+
+		*f = FileFloat(value)
+		return nil
+	}
+
+	value, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return err
+	}
+
+	*f = FileFloat(value)
+	return nil
+}
+
+// FileBar is the action and the outcome of a strategy at a single bar.
+type FileBar struct {
+	// Date is the date of the bar.
+	Date time.Time `json:"date" header:"Date"`
+
+	// Close is the closing price of the bar.
+	Close float64 `json:"close" header:"Close"`
+
+	// Action is the action recommended by the strategy.
+	Action strategy.Action `json:"action" header:"Action"`
+
+	// Outcome is the strategy outcome percent at the bar.
+	Outcome float64 `json:"outcome" header:"Outcome"`
+}
+
+// fileReport writes the strategy results of the assets to files named after the assets, and
+// the per-bar actions and outcomes of the strategies to files named after the assets and the
+// strategies, as they arrive.
+type fileReport struct {
+	// outputDir is the output directory for the generated files.
+	outputDir string
+
+	// extension is the file name extension for the generated files.
+	extension string
+
+	// writeResults writes the strategy results to the file with the given name.
+	writeResults func(fileName string, results <-chan *FileStrategyResult) error
+
+	// writeBars writes the per-bar actions and outcomes to the file with the given name.
+	writeBars func(fileName string, bars <-chan *FileBar) error
+
+	// assets is the mapping from the asset name to the asset being written.
+	assets map[string]*fileReportAsset
+	mu     sync.Mutex
+}
+
+// fileReportAsset is an asset whose strategy results are being written.
+type fileReportAsset struct {
+	// results are the strategy results to write.
+	results chan *FileStrategyResult
+
+	// done delivers the outcome of writing the results.
+	done chan error
+}
+
+// newFileReport initializes a new file report writing the files with the given extension to
+// the given output directory using the given write functions.
+func newFileReport(outputDir, extension string, writeResults func(string, <-chan *FileStrategyResult) error, writeBars func(string, <-chan *FileBar) error) *fileReport {
+	return &fileReport{
+		outputDir:    outputDir,
+		extension:    extension,
+		writeResults: writeResults,
+		writeBars:    writeBars,
+		assets:       make(map[string]*fileReportAsset),
+	}
+}
+
+// begin starts writing the results for the given asset.
+func (f *fileReport) begin(name string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	_, ok := f.assets[name]
+	if ok {
+		return fmt.Errorf("asset has already begun: %s", name)
+	}
+
+	fileAsset := &fileReportAsset{
+		results: make(chan *FileStrategyResult),
+		done:    make(chan error, 1),
+	}
+
+	f.assets[name] = fileAsset
+
+	go func() {
+		fileAsset.done <- f.writeResults(f.fileName(name), fileAsset.results)
+	}()
+
+	return nil
+}
+
+// write reads the given snapshots, actions, outcomes, and costs, writing the bars if requested
+// as they are read, and writes the summarized result of the strategy for the given asset along
+// with its metrics computed using the given calculator.
+func (f *fileReport) write(assetName string, currentStrategy strategy.Strategy, snapshots <-chan *asset.Snapshot, actions <-chan strategy.Action, outcomes <-chan float64, costs <-chan float64, withBars bool, calculator *metrics.Calculator) error {
+	f.mu.Lock()
+	fileAsset, ok := f.assets[assetName]
+	f.mu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("asset has not begun: %s", assetName)
+	}
+
+	var bars chan *FileBar
+
+	done := make(chan error, 1)
+
+	if withBars {
+		bars = make(chan *FileBar)
+
+		go func() {
+			done <- f.writeBars(f.fileName(fmt.Sprintf("%s - %s", assetName, currentStrategy.Name())), bars)
+		}()
+	} else {
+		done <- nil
+	}
+
+	result := newFileStrategyResult(assetName, currentStrategy, snapshots, actions, outcomes, costs, bars, calculator)
+
+	err := <-done
+	if err != nil {
+		return fmt.Errorf("unable to write strategy report for %s: %w", assetName, err)
+	}
+
+	fileAsset.results <- result
+
+	return nil
+}
+
+// end finishes writing the results for the given asset.
+func (f *fileReport) end(name string) error {
+	f.mu.Lock()
+	fileAsset, ok := f.assets[name]
+	delete(f.assets, name)
+	f.mu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("asset has not begun: %s", name)
+	}
+
+	close(fileAsset.results)
+
+	err := <-fileAsset.done
+	if err != nil {
+		return fmt.Errorf("unable to write asset report for %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// fileName returns the name of the file in the output directory for the given base name.
+func (f *fileReport) fileName(name string) string {
+	return filepath.Join(f.outputDir, name+f.extension)
+}
+
+// newFileStrategyResult reads the given snapshots, actions, outcomes, and costs in lockstep,
+// and summarizes them as the result of the strategy, along with its metrics computed using
+// the given calculator. The bars are sent to the given channel as they are read, if it is not
+// nil, and the channel is closed at the end. Only the equity curve is kept for the metrics.
+func newFileStrategyResult(assetName string, currentStrategy strategy.Strategy, snapshots <-chan *asset.Snapshot, actions <-chan strategy.Action, outcomes <-chan float64, costs <-chan float64, bars chan<- *FileBar, calculator *metrics.Calculator) *FileStrategyResult {
+	result := &FileStrategyResult{
+		Asset:    assetName,
+		Strategy: currentStrategy.Name(),
+		Action:   strategy.Hold,
+	}
+
+	first := true
+
+	equities := []float64{}
+	equityActions := []strategy.Action{}
+
+	// Detect the interval like the asset.DetectInterval function.
+	var interval asset.Interval
+	var previous *asset.Snapshot
+
+	for action := range actions {
+		snapshot, ok := <-snapshots
+		if !ok {
+			break
+		}
+
+		outcome := <-outcomes
+		cost := <-costs * 100
+
+		// Count the periods since the last change of action like the helper.Since function.
+		if first || action != result.Action {
+			first = false
+			result.Since = 0
+		} else {
+			result.Since++
+		}
+
+		if action != strategy.Hold {
+			result.Transactions++
+		}
+
+		if previous != nil {
+			gap := asset.Interval(snapshot.Date.Sub(previous.Date))
+			if gap > 0 && (interval == 0 || gap < interval) {
+				interval = gap
+			}
+		}
+
+		previous = snapshot
+
+		// The outcomes are relative to a single unit of capital.
+		equities = append(equities, 1+outcome)
+		equityActions = append(equityActions, action)
+
+		result.Action = action
+		result.Outcome = outcome * 100
+		result.Costs = cost
+
+		if bars != nil {
+			bars <- &FileBar{
+				Date:    snapshot.Date,
+				Close:   snapshot.Close,
+				Action:  action,
+				Outcome: outcome * 100,
+			}
+		}
+	}
+
+	if bars != nil {
+		close(bars)
+	}
+
+	// Drain the remaining inputs as they may share a common source.
+	go helper.Drain(snapshots)
+	go helper.Drain(actions)
+	go helper.Drain(outcomes)
+	go helper.Drain(costs)
+
+	m := calculator.ForInterval(interval).ComputeFromEquities(equities, equityActions)
+
+	result.Cagr = m.Cagr
+	result.Volatility = m.Volatility
+	result.Sharpe = m.Sharpe
+	result.Sortino = m.Sortino
+	result.Calmar = m.Calmar
+	result.MaxDrawdown = m.MaxDrawdown
+	result.MaxDrawdownDuration = m.MaxDrawdownDuration
+	result.WinRate = m.WinRate
+	result.ProfitFactor = FileFloat(m.ProfitFactor)
+	result.AverageWin = m.AverageWin
+	result.AverageLoss = m.AverageLoss
+	result.Exposure = m.Exposure
+	result.Trades = m.Trades
+
+	return result
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package backtest_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/miromax42/indicator/v2/backtest"
+)
+
+func TestFileFloatJSON(t *testing.T) {
+	tests := []struct {
+		value    float64
+		expected string
+	}{
+		{1.5, "1.5"},
+		{0, "0"},
+		{math.Inf(1), `"+Inf"`},
+		{math.Inf(-1), `"-Inf"`},
+	}
+
+	for _, test := range tests {
+		data, err := json.Marshal(backtest.FileFloat(test.value))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(data) != test.expected {
+			t.Fatalf("actual %s expected %s", data, test.expected)
+		}
+
+		var actual backtest.FileFloat
+
+		err = json.Unmarshal(data, &actual)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if float64(actual) != test.value {
+			t.Fatalf("actual %v expected %v", actual, test.value)
+		}
+	}
+}
+
+func TestFileFloatJSONInvalid(t *testing.T) {
+	var actual backtest.FileFloat
+
+	for _, data := range []string{`"abc"`, `true`} {
+		err := json.Unmarshal([]byte(data), &actual)
+		if err == nil {
+			t.Fatalf("expected error for %s", data)
+		}
+	}
+}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/miromax42/indicator/v2/asset"
 	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/metrics"
 	"github.com/miromax42/indicator/v2/strategy"
 )
 
@@ -50,9 +51,15 @@ type HTMLReport struct {
 	// WriteStrategyReports indicates whether the individual strategy reports should be generated.
 	WriteStrategyReports bool
 
-	// DateFormat is the date format that is used in the reports.
+	// DateFormat is the date format that is used in the reports. When it is empty, the
+	// format is chosen from the interval detected from the snapshots, keeping the times
+	// of the intraday bars.
 	DateFormat string
 
+	// Calculator is the risk and performance metrics calculator. The periods per year
+	// are derived from the interval detected from the snapshots.
+	Calculator *metrics.Calculator
+
 	// Logger is the slog logger instance.
 	Logger *slog.Logger
 }
@@ -76,6 +83,12 @@ type htmlReportResult struct {
 
 	// Transactions is the number of transactions made by the strategy.
 	Transactions int
+
+	// Costs is the transaction costs paid relative to the invested capital.
+	Costs float64
+
+	// Metrics is the risk and performance metrics of the strategy.
+	Metrics *metrics.Metrics
 }
 
 // NewHTMLReport initializes a new HTML report instance.
@@ -84,7 +97,7 @@ func NewHTMLReport(outputDir string) *HTMLReport {
 		outputDir:            outputDir,
 		assetResults:         make(map[string][]*htmlReportResult),
 		WriteStrategyReports: DefaultWriteStrategyReports,
-		DateFormat:           helper.DefaultReportDateFormat,
+		Calculator:           metrics.NewCalculator(),
 		Logger:               slog.Default(),
 	}
 }
@@ -119,17 +132,61 @@ func (h *HTMLReport) AssetBegin(name string, strategies []strategy.Strategy) err
 
 // Write writes the given strategy actions and outomes to the report.
 func (h *HTMLReport) Write(assetName string, currentStrategy strategy.Strategy, snapshots <-chan *asset.Snapshot, actions <-chan strategy.Action, outcomes <-chan float64) error {
-	actionsSplice := helper.Duplicate(actions, 3)
+	outcomes, costs := withoutCosts(outcomes)
+	return h.WriteWithCosts(assetName, currentStrategy, snapshots, actions, outcomes, costs)
+}
+
+// WriteWithCosts writes the given strategy actions, outcomes, and cumulative transaction costs to the report.
+func (h *HTMLReport) WriteWithCosts(assetName string, currentStrategy strategy.Strategy, snapshots <-chan *asset.Snapshot, actions <-chan strategy.Action, outcomes <-chan float64, costs <-chan float64) error {
+	snapshotsSplice := helper.Duplicate(snapshots, 2)
+	actionsSplice := helper.Duplicate(actions, 4)
+	outcomesSplice := helper.Duplicate(outcomes, 3)
+	costsSplice := helper.Duplicate(costs, 2)
+
+	intervals := make(chan asset.Interval, 1)
+	go func() {
+		intervals <- asset.DetectInterval(snapshotsSplice[1])
+	}()
+
+	computed := make(chan *metrics.Metrics, 1)
+	go func() {
+		equities, actions := metrics.Collect(outcomesSplice[1], actionsSplice[3])
+		computed <- h.Calculator.ForInterval(<-intervals).ComputeFromEquities(equities, actions)
+	}()
 
 	actions = helper.Last(actionsSplice[0], 1)
 	sinces := helper.Last(helper.Since[strategy.Action, int](actionsSplice[1]), 1)
-	outcomes = helper.Last(outcomes, 1)
+	outcomes = helper.Last(outcomesSplice[0], 1)
+	costs = helper.Last(costsSplice[0], 1)
 	transactions := helper.Last(strategy.CountTransactions(actionsSplice[2]), 1)
 
-	// Generate inidividual strategy report.
+	// Generate inidividual strategy report with the outcomes after the transaction costs.
 	if h.WriteStrategyReports {
-		report := currentStrategy.Report(snapshots)
+		// Collect the inputs concurrently, as they may share a common source, to detect the
+		// interval before the report is written.
+		collectedOutcomes := make(chan []float64, 1)
+		go func() {
+			collectedOutcomes <- helper.ChanToSlice(outcomesSplice[2])
+		}()
+
+		collectedCosts := make(chan []float64, 1)
+		go func() {
+			collectedCosts <- helper.ChanToSlice(costsSplice[1])
+		}()
+
+		snapshotsSlice := helper.ChanToSlice(snapshotsSplice[0])
+
+		report := strategy.ReportWithCosts(
+			currentStrategy,
+			helper.SliceToChan(snapshotsSlice),
+			helper.SliceToChan(<-collectedOutcomes),
+			helper.SliceToChan(<-collectedCosts),
+		)
+
 		report.DateFormat = h.DateFormat
+		if report.DateFormat == "" {
+			report.DateFormat = reportDateFormat(asset.DetectInterval(helper.SliceToChan(snapshotsSlice)))
+		}
 
 		reportFile := h.strategyReportFileName(assetName, currentStrategy.Name())
 
@@ -138,7 +195,9 @@ func (h *HTMLReport) Write(assetName string, currentStrategy strategy.Strategy,
 			return fmt.Errorf("unable to write report for %s (%v)", assetName, err)
 		}
 	} else {
-		go helper.Drain(snapshots)
+		go helper.Drain(snapshotsSplice[0])
+		go helper.Drain(outcomesSplice[2])
+		go helper.Drain(costsSplice[1])
 	}
 
 	// Get asset strategy results.
@@ -157,6 +216,8 @@ func (h *HTMLReport) Write(assetName string, currentStrategy strategy.Strategy,
 		Since:        <-sinces,
 		Outcome:      <-outcomes * 100,
 		Transactions: <-transactions,
+		Costs:        <-costs * 100,
+		Metrics:      <-computed,
 	})
 
 	return nil
@@ -227,7 +288,11 @@ func (h *HTMLReport) writeAssetReport(name string, results []*htmlReportResult)
 
 	defer helper.CloseAndLogError(file, "unable to close asset report file")
 
-	tmpl := template.Must(template.New("report").Parse(htmlAssetReportTmpl))
+	tmpl := template.Must(template.New("report").Funcs(template.FuncMap{
+		"percent": func(n float64) float64 {
+			return n * 100
+		},
+	}).Parse(htmlAssetReportTmpl))
 
 	err = tmpl.Execute(file, model)
 	if err != nil {
@@ -265,3 +330,13 @@ func (h *HTMLReport) writeReport() error {
 
 	return nil
 }
+
+// reportDateFormat returns the date format for the bars with the given interval, keeping the
+// times of the intraday bars.
+func reportDateFormat(interval asset.Interval) string {
+	if interval.IsIntraday() {
+		return helper.ReportDateTimeFormat
+	}
+
+	return helper.ReportDateFormat
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package backtest
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/metrics"
+	"github.com/miromax42/indicator/v2/strategy"
+)
+
+// JSONReport is the backtest JSON report. It writes the strategy results of each asset
+// to a JSON file named after the asset, in the order the strategies complete. When enabled,
+// the per-bar actions and outcomes of each strategy are written to a separate JSON file
+// named after the asset and the strategy. The results and the bars are written as they
+// arrive, keeping only the equity curve in memory for the metrics.
+type JSONReport struct {
+	// outputDir is the output directory for the generated reports.
+	outputDir string
+
+	// files writes the strategy results and the bars of the assets.
+	files *fileReport
+
+	// WriteBars indicates whether the per-bar actions and outcomes should be written.
+	WriteBars bool
+
+	// Calculator is the risk and performance metrics calculator. The periods per year
+	// are derived from the interval detected from the snapshots.
+	Calculator *metrics.Calculator
+}
+
+// NewJSONReport initializes a new JSON report instance.
+func NewJSONReport(outputDir string) *JSONReport {
+	return &JSONReport{
+		outputDir:  outputDir,
+		files:      newFileReport(outputDir, ".json", writeJSONReportFile[FileStrategyResult], writeJSONReportFile[FileBar]),
+		WriteBars:  DefaultWriteBars,
+		Calculator: metrics.NewCalculator(),
+	}
+}
+
+// Begin is called when the backtest begins.
+func (j *JSONReport) Begin(_ []string, _ []strategy.Strategy) error {
+	// Make sure that output directory exists.
+	err := os.MkdirAll(j.outputDir, 0o700)
+	if err != nil {
+		return fmt.Errorf("unable to make the output directory: %w", err)
+	}
+
+	return nil
+}
+
+// AssetBegin is called when backtesting for the given asset begins.
+func (j *JSONReport) AssetBegin(name string, _ []strategy.Strategy) error {
+	return j.files.begin(name)
+}
+
+// Write writes the given strategy actions and outomes to the report.
+func (j *JSONReport) Write(assetName string, currentStrategy strategy.Strategy, snapshots <-chan *asset.Snapshot, actions <-chan strategy.Action, outcomes <-chan float64) error {
+	outcomes, costs := withoutCosts(outcomes)
+	return j.WriteWithCosts(assetName, currentStrategy, snapshots, actions, outcomes, costs)
+}
+
+// WriteWithCosts writes the given strategy actions, outcomes, and cumulative transaction costs to the report.
+func (j *JSONReport) WriteWithCosts(assetName string, currentStrategy strategy.Strategy, snapshots <-chan *asset.Snapshot, actions <-chan strategy.Action, outcomes <-chan float64, costs <-chan float64) error {
+	return j.files.write(assetName, currentStrategy, snapshots, actions, outcomes, costs, j.WriteBars, j.Calculator)
+}
+
+// AssetEnd is called when backtesting for the given asset ends.
+func (j *JSONReport) AssetEnd(name string) error {
+	return j.files.end(name)
+}
+
+// End is called when the backtest ends.
+func (*JSONReport) End() error {
+	return nil
+}
+
+// writeJSONReportFile writes the given rows to the JSON file with the given name as an
+// array, replacing the existing file. Each row is written as it arrives.
+func writeJSONReportFile[T any](fileName string, rows <-chan *T) error {
+	defer helper.Drain(rows)
+
+	file, err := os.Create(filepath.Clean(fileName))
+	if err != nil {
+		return err
+	}
+
+	defer helper.CloseAndLogError(file, "unable to close report file")
+
+	writer := bufio.NewWriter(file)
+	count := 0
+
+	for row := range rows {
+		data, err := json.MarshalIndent(row, "  ", "  ")
+		if err != nil {
+			return err
+		}
+
+		separator := ",\n  "
+		if count == 0 {
+			separator = "[\n  "
+		}
+
+		_, err = writer.WriteString(separator)
+		if err != nil {
+			return err
+		}
+
+		_, err = writer.Write(data)
+		if err != nil {
+			return err
+		}
+
+		count++
+	}
+
+	end := "\n]\n"
+	if count == 0 {
+		end = "[]\n"
+	}
+
+	_, err = writer.WriteString(end)
+	if err != nil {
+		return err
+	}
+
+	return writer.Flush()
+}
@@ -0,0 +1,269 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package backtest_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/backtest"
+	"github.com/miromax42/indicator/v2/strategy"
+	"github.com/miromax42/indicator/v2/strategy/momentum"
+	"github.com/miromax42/indicator/v2/strategy/trend"
+	"github.com/miromax42/indicator/v2/strategy/volatility"
+)
+
+func TestJSONReport(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/repository")
+
+	outputDir, err := os.MkdirTemp("", "backtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(outputDir)
+
+	jsonReport := backtest.NewJSONReport(outputDir)
+	jsonReport.WriteBars = true
+
+	backtester := backtest.NewBacktest(repository, jsonReport)
+	backtester.Names = append(backtester.Names, "brk-b")
+	backtester.Strategies = append(backtester.Strategies, trend.NewMacdStrategy(), strategy.NewBuyAndHoldStrategy())
+	backtester.LastDays = 10000
+
+	err = backtester.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(outputDir, "brk-b.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var results []*backtest.FileStrategyResult
+
+	err = json.Unmarshal(data, &results)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("actual %v expected %v", len(results), 2)
+	}
+
+	for _, result := range results {
+		data, err := os.ReadFile(filepath.Join(outputDir, "brk-b - "+result.Strategy+".json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var bars []*backtest.FileBar
+
+		err = json.Unmarshal(data, &bars)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if result.Asset != "brk-b" || len(bars) != 251 {
+			t.Fatalf("actual %v %v expected %v %v", result.Asset, len(bars), "brk-b", 251)
+		}
+
+		last := bars[len(bars)-1]
+		if last.Outcome != result.Outcome || last.Action != result.Action {
+			t.Fatalf("actual %v %v expected %v %v", last.Outcome, last.Action, result.Outcome, result.Action)
+		}
+
+		if result.Strategy == strategy.NewBuyAndHoldStrategy().Name() && (result.Transactions != 1 || result.Since != 249) {
+			t.Fatalf("actual %v %v expected %v %v", result.Transactions, result.Since, 1, 249)
+		}
+	}
+}
+
+func TestJSONReportWithoutBars(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/repository")
+
+	outputDir, err := os.MkdirTemp("", "backtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(outputDir)
+
+	backtester := backtest.NewBacktest(repository, backtest.NewJSONReport(outputDir))
+	backtester.Names = append(backtester.Names, "brk-b")
+	backtester.Strategies = append(backtester.Strategies, trend.NewMacdStrategy())
+	backtester.LastDays = 10000
+
+	err = backtester.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(outputDir, "brk-b.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var results []*backtest.FileStrategyResult
+
+	err = json.Unmarshal(data, &results)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != 1 {
+		t.Fatalf("actual %v expected %v", len(results), 1)
+	}
+
+	_, err = os.Stat(filepath.Join(outputDir, "brk-b - "+results[0].Strategy+".json"))
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("bars are written: %v", err)
+	}
+}
+
+func TestJSONReportWithoutResults(t *testing.T) {
+	outputDir, err := os.MkdirTemp("", "backtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(outputDir)
+
+	jsonReport := backtest.NewJSONReport(outputDir)
+
+	err = jsonReport.Begin(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = jsonReport.AssetBegin("brk-b", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = jsonReport.AssetEnd("brk-b")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(outputDir, "brk-b.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != "[]\n" {
+		t.Fatalf("actual %q expected %q", data, "[]\n")
+	}
+}
+
+func TestJSONReportReadAheadStrategies(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/repository")
+
+	outputDir, err := os.MkdirTemp("", "backtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(outputDir)
+
+	jsonReport := backtest.NewJSONReport(outputDir)
+	jsonReport.WriteBars = true
+
+	backtester := backtest.NewBacktest(repository, jsonReport)
+	backtester.Names = append(backtester.Names, "brk-b")
+	backtester.Strategies = append(backtester.Strategies, momentum.AllStrategies()...)
+	backtester.Strategies = append(backtester.Strategies, volatility.AllStrategies()...)
+	backtester.LastDays = 10000
+
+	err = backtester.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(outputDir, "brk-b.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var results []*backtest.FileStrategyResult
+
+	err = json.Unmarshal(data, &results)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != len(backtester.Strategies) {
+		t.Fatalf("actual %v expected %v", len(results), len(backtester.Strategies))
+	}
+}
+
+func TestJSONReportMetrics(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/repository")
+
+	outputDir, err := os.MkdirTemp("", "backtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(outputDir)
+
+	strategies := []strategy.Strategy{
+		trend.NewMacdStrategy(),
+		strategy.NewBuyAndHoldStrategy(),
+	}
+
+	dataReport := backtest.NewDataReport()
+
+	for _, report := range []backtest.Report{backtest.NewJSONReport(outputDir), dataReport} {
+		backtester := backtest.NewBacktest(repository, report)
+		backtester.Names = append(backtester.Names, "brk-b")
+		backtester.Strategies = strategies
+		backtester.LastDays = 10000
+
+		err = backtester.Run()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	data, err := os.ReadFile(filepath.Join(outputDir, "brk-b.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var results []*backtest.FileStrategyResult
+
+	err = json.Unmarshal(data, &results)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != len(strategies) {
+		t.Fatalf("actual %v expected %v", len(results), len(strategies))
+	}
+
+	for _, result := range results {
+		i := slices.IndexFunc(dataReport.Results["brk-b"], func(r *backtest.DataStrategyResult) bool {
+			return r.Strategy.Name() == result.Strategy
+		})
+		if i == -1 {
+			t.Fatalf("unknown strategy %v", result.Strategy)
+		}
+
+		expected := dataReport.Results["brk-b"][i].Metrics
+
+		if result.Sharpe != expected.Sharpe || result.MaxDrawdown != expected.MaxDrawdown ||
+			float64(result.ProfitFactor) != expected.ProfitFactor || result.Trades != expected.Trades {
+			t.Fatalf("actual %v expected %v", result, expected)
+		}
+	}
+}
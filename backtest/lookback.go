@@ -0,0 +1,37 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package backtest
+
+import (
+	"time"
+
+	"github.com/miromax42/indicator/v2/asset"
+)
+
+// lookbackSince returns the date the lookback begins. The last bars take precedence over the
+// last duration and the last days when they are positive, beginning at the start of the
+// history, as the bars can span any number of days. The last duration takes precedence over
+// the last days when it is positive, allowing intraday lookbacks such as six hours.
+func lookbackSince(now time.Time, lastDays int, lastDuration time.Duration, lastBars int) time.Time {
+	if lastBars > 0 {
+		return time.Time{}
+	}
+
+	if lastDuration > 0 {
+		return now.Add(-lastDuration)
+	}
+
+	return now.AddDate(0, 0, -lastDays)
+}
+
+// lookbackBars returns the most recent given number of bars from the snapshots. All
+// snapshots are returned when the number of bars is not positive.
+func lookbackBars(snapshots []*asset.Snapshot, lastBars int) []*asset.Snapshot {
+	if lastBars <= 0 || lastBars >= len(snapshots) {
+		return snapshots
+	}
+
+	return snapshots[len(snapshots)-lastBars:]
+}
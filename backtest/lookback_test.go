@@ -0,0 +1,160 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package backtest_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/backtest"
+	"github.com/miromax42/indicator/v2/strategy"
+)
+
+func runLookbackBacktest(t *testing.T, configure func(*backtest.Backtest)) []*backtest.FileBar {
+	repository := asset.NewFileSystemRepository("testdata/repository")
+
+	outputDir, err := os.MkdirTemp("", "backtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(outputDir)
+
+	jsonReport := backtest.NewJSONReport(outputDir)
+	jsonReport.WriteBars = true
+
+	backtester := backtest.NewBacktest(repository, jsonReport)
+	backtester.Names = append(backtester.Names, "brk-b")
+	backtester.Strategies = append(backtester.Strategies, strategy.NewBuyAndHoldStrategy())
+	configure(backtester)
+
+	err = backtester.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(outputDir, "brk-b - "+strategy.NewBuyAndHoldStrategy().Name()+".json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var bars []*backtest.FileBar
+
+	err = json.Unmarshal(data, &bars)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return bars
+}
+
+func TestBacktestLastBars(t *testing.T) {
+	bars := runLookbackBacktest(t, func(backtester *backtest.Backtest) {
+		backtester.LastDays = 10000
+		backtester.LastBars = 20
+	})
+
+	if len(bars) != 20 {
+		t.Fatalf("actual %v expected %v", len(bars), 20)
+	}
+
+	expected := time.Date(2023, 11, 29, 0, 0, 0, 0, time.UTC)
+	if !bars[len(bars)-1].Date.Equal(expected) {
+		t.Fatalf("actual %v expected %v", bars[len(bars)-1].Date, expected)
+	}
+}
+
+func TestBacktestLastBarsBeyondLastDays(t *testing.T) {
+	bars := runLookbackBacktest(t, func(backtester *backtest.Backtest) {
+		backtester.LastDays = 1
+		backtester.LastBars = 20
+	})
+
+	if len(bars) != 20 {
+		t.Fatalf("actual %v expected %v", len(bars), 20)
+	}
+}
+
+func TestBacktestLastDuration(t *testing.T) {
+	bars := runLookbackBacktest(t, func(backtester *backtest.Backtest) {
+		backtester.LastDays = 10000
+		backtester.LastDuration = time.Hour
+	})
+
+	if len(bars) != 0 {
+		t.Fatalf("actual %v expected %v", len(bars), 0)
+	}
+}
+
+func TestBacktestResampler(t *testing.T) {
+	bars := runLookbackBacktest(t, func(backtester *backtest.Backtest) {
+		backtester.LastDays = 10000
+		backtester.LastBars = 4
+		backtester.Resampler = asset.NewResampler(asset.OneWeek)
+	})
+
+	expected := []time.Time{
+		time.Date(2023, 11, 10, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 11, 17, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 11, 24, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 11, 29, 0, 0, 0, 0, time.UTC),
+	}
+
+	if len(bars) != len(expected) {
+		t.Fatalf("actual %v expected %v", len(bars), len(expected))
+	}
+
+	for i, bar := range bars {
+		if !bar.Date.Equal(expected[i]) {
+			t.Fatalf("actual %v expected %v", bar.Date, expected[i])
+		}
+	}
+}
+
+// sinceRecordingRepository records the date the snapshots are requested since.
+type sinceRecordingRepository struct {
+	asset.Repository
+	since time.Time
+}
+
+func (r *sinceRecordingRepository) GetSince(name string, date time.Time) (<-chan *asset.Snapshot, error) {
+	r.since = date
+	return r.Repository.GetSince(name, date)
+}
+
+func TestBacktestResamplerPeriodStart(t *testing.T) {
+	repository := &sinceRecordingRepository{
+		Repository: asset.NewFileSystemRepository("testdata/repository"),
+	}
+
+	outputDir, err := os.MkdirTemp("", "backtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(outputDir)
+
+	backtester := backtest.NewBacktest(repository, backtest.NewJSONReport(outputDir))
+	backtester.Names = append(backtester.Names, "brk-b")
+	backtester.Strategies = append(backtester.Strategies, strategy.NewBuyAndHoldStrategy())
+	backtester.LastDuration = 3 * 24 * time.Hour
+	backtester.Resampler = asset.NewResampler(asset.OneWeek)
+
+	latest := time.Now().Add(-backtester.LastDuration)
+
+	err = backtester.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := backtester.Resampler.PeriodStart(repository.since)
+	if !repository.since.Equal(expected) || repository.since.After(latest) {
+		t.Fatalf("actual %v expected %v", repository.since, expected)
+	}
+}
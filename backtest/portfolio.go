@@ -0,0 +1,470 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package backtest
+
+import (
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/strategy"
+	"github.com/miromax42/indicator/v2/volatility"
+)
+
+const (
+	// DefaultPortfolioInitialCash is the default initial cash balance of the portfolio.
+	DefaultPortfolioInitialCash = 10000
+
+	// DefaultPortfolioName is the default name the portfolio is reported under.
+	DefaultPortfolioName = "Portfolio"
+)
+
+// PortfolioTrade is a single trade made by the portfolio.
+type PortfolioTrade struct {
+	// Date is the date of the trade.
+	Date time.Time
+
+	// Asset is the name of the traded asset.
+	Asset string
+
+	// Action is Buy for entering a long position, Short for entering a short
+	// position, and Sell for exiting either one of them.
+	Action strategy.Action
+
+	// Shares is the number of shares traded. It is positive for the short
+	// positions as well.
+	Shares float64
+
+	// Price is the price of a single share after the slippage.
+	Price float64
+
+	// Commission is the commission paid for the trade.
+	Commission float64
+}
+
+// PortfolioEquity is the value of the portfolio at the end of a date.
+type PortfolioEquity struct {
+	// Date is the date of the equity.
+	Date time.Time
+
+	// Cash is the available cash.
+	Cash float64
+
+	// Equity is the cash and the value of the open positions.
+	Equity float64
+
+	// Costs is the cumulative transaction costs paid until the end of the date.
+	Costs float64
+
+	// Action is Buy if a long position is entered, Short if a short position
+	// is entered, Sell if a position is exited and no position is entered, and
+	// Hold otherwise.
+	Action strategy.Action
+}
+
+// PortfolioResult is the result of a portfolio backtest.
+type PortfolioResult struct {
+	// InitialCash is the initial cash balance of the portfolio.
+	InitialCash float64
+
+	// Equities is the equity curve of the portfolio.
+	Equities []*PortfolioEquity
+
+	// Trades are the trades made by the portfolio.
+	Trades []*PortfolioTrade
+}
+
+// Outcome returns the return of the portfolio relative to its initial cash.
+func (r *PortfolioResult) Outcome() float64 {
+	if len(r.Equities) == 0 {
+		return 0
+	}
+
+	return r.Equities[len(r.Equities)-1].Equity/r.InitialCash - 1
+}
+
+// Portfolio simulates a strategy applied to a set of assets sharing a single cash
+// balance. Each position is sized by the position sizer when the strategy recommends
+// buying or shorting an asset. A long position is exited entirely when the strategy
+// recommends selling or shorting the asset, and a short position is covered entirely
+// when the strategy recommends selling or buying it. The proceeds of a short position
+// are held until it is covered, so they are not available for the new positions. Each
+// trade pays the commission and slips the price based on the transaction costs. The
+// resulting equity curve is written to the report as a single asset.
+type Portfolio struct {
+	// repository is the repository to retrieve the assets from.
+	repository asset.Repository
+
+	// report is the report writer for the portfolio.
+	report Report
+
+	// Names is the names of the assets in the portfolio.
+	Names []string
+
+	// Strategy is the strategy to apply to each asset.
+	Strategy strategy.Strategy
+
+	// Sizer is the position sizer for the new positions.
+	Sizer PositionSizer
+
+	// Costs is the transaction costs applied to the trades. Its capital is not
+	// used, as the positions are sized by the position sizer.
+	Costs *strategy.Costs
+
+	// InitialCash is the initial cash balance.
+	InitialCash float64
+
+	// AtrPeriod is the period of the ATR provided to the position sizer.
+	AtrPeriod int
+
+	// LastDays is the number of days the portfolio backtest should go back.
+	LastDays int
+
+	// LastDuration is the duration the portfolio backtest should go back. It takes
+	// precedence over the last days when it is positive.
+	LastDuration time.Duration
+
+	// LastBars is the number of most recent bars of each asset to simulate. It takes
+	// precedence over the last days and the last duration when it is positive. All bars
+	// since the lookback date are used when it is not positive.
+	LastBars int
+
+	// Name is the name the portfolio is reported under.
+	Name string
+
+	// Logger is the slog logger instance.
+	Logger *slog.Logger
+}
+
+// portfolioBar is a single bar of an asset in the portfolio.
+type portfolioBar struct {
+	// snapshot is the asset snapshot.
+	snapshot *asset.Snapshot
+
+	// action is the action recommended by the strategy.
+	action strategy.Action
+
+	// atr is the Average True Range at the snapshot.
+	atr float64
+}
+
+// NewPortfolio function initializes a new portfolio instance.
+func NewPortfolio(repository asset.Repository, report Report) *Portfolio {
+	return &Portfolio{
+		repository:  repository,
+		report:      report,
+		Names:       []string{},
+		Strategy:    strategy.NewBuyAndHoldStrategy(),
+		Sizer:       NewEqualWeightSizer(),
+		Costs:       strategy.NewCosts(),
+		InitialCash: DefaultPortfolioInitialCash,
+		AtrPeriod:   volatility.DefaultAtrPeriod,
+		LastDays:    DefaultLastDays,
+		Name:        DefaultPortfolioName,
+		Logger:      slog.Default(),
+	}
+}
+
+// Run simulates the portfolio, writes its equity curve to the report, and returns
+// the result. In the absence of explicitly defined assets, encompasses all assets
+// within the repository. Assets that cannot be read are skipped.
+func (p *Portfolio) Run() (*PortfolioResult, error) {
+	if len(p.Names) == 0 {
+		assets, err := p.repository.Assets()
+		if err != nil {
+			return nil, err
+		}
+
+		p.Names = assets
+	}
+
+	since := lookbackSince(time.Now(), p.LastDays, p.LastDuration, p.LastBars)
+	assets := make(map[string][]*portfolioBar, len(p.Names))
+
+	for _, name := range p.Names {
+		bars, err := p.loadBars(name, since)
+		if err != nil {
+			p.Logger.Error("Unable to load asset.", "asset", name, "error", err)
+			continue
+		}
+
+		assets[name] = bars
+	}
+
+	result := p.simulate(assets)
+
+	err := p.writeReport(result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// loadBars retrieves the snapshots of the asset with the given name, and computes
+// the strategy actions and the ATR for each of them.
+func (p *Portfolio) loadBars(name string, since time.Time) ([]*portfolioBar, error) {
+	snapshots, errs, err := asset.GetSinceWithError(p.repository, name, since)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotsSlice := helper.ChanToSlice(snapshots)
+
+	err = <-errs
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotsSlice = lookbackBars(snapshotsSlice, p.LastBars)
+
+	actions := helper.ChanToSlice(p.Strategy.Compute(helper.SliceToChan(snapshotsSlice)))
+	atr := volatility.NewAtrWithPeriod[float64](p.AtrPeriod)
+
+	bars := make([]*portfolioBar, len(snapshotsSlice))
+
+	for i, snapshot := range snapshotsSlice {
+		bars[i] = &portfolioBar{
+			snapshot: snapshot,
+			action:   strategy.Hold,
+		}
+
+		if i < len(actions) {
+			bars[i].action = actions[i]
+		}
+
+		bars[i].atr, _ = atr.Update(snapshot.High, snapshot.Low, snapshot.Close)
+	}
+
+	return bars, nil
+}
+
+// simulate walks through the bars of all assets date by date, exits and enters
+// positions based on the recommended actions, and records the equity curve.
+func (p *Portfolio) simulate(assets map[string][]*portfolioBar) *PortfolioResult {
+	result := &PortfolioResult{
+		InitialCash: p.InitialCash,
+	}
+
+	names := make([]string, 0, len(assets))
+	dates := []time.Time{}
+
+	for name, bars := range assets {
+		names = append(names, name)
+
+		for _, bar := range bars {
+			dates = append(dates, bar.snapshot.Date)
+		}
+	}
+
+	slices.Sort(names)
+	slices.SortFunc(dates, func(a, b time.Time) int {
+		return a.Compare(b)
+	})
+	dates = slices.CompactFunc(dates, func(a, b time.Time) bool {
+		return a.Equal(b)
+	})
+
+	cash := p.InitialCash
+	costs := 0.
+	cursors := make(map[string]int, len(names))
+	prices := make(map[string]float64, len(names))
+	positions := make(map[string]float64, len(names))
+
+	equity := func() float64 {
+		total := cash
+
+		for name, shares := range positions {
+			total += shares * prices[name]
+		}
+
+		return total
+	}
+
+	// The proceeds of the short positions are held until they are covered, so the
+	// cash available for the new positions excludes the value of the short positions.
+	availableCash := func() float64 {
+		total := cash
+
+		for name, shares := range positions {
+			total += min(shares, 0) * prices[name]
+		}
+
+		return total
+	}
+
+	for _, date := range dates {
+		current := make(map[string]*portfolioBar, len(names))
+
+		for _, name := range names {
+			bars := assets[name]
+			cursor := cursors[name]
+
+			if cursor < len(bars) && bars[cursor].snapshot.Date.Equal(date) {
+				current[name] = bars[cursor]
+				prices[name] = bars[cursor].snapshot.Close
+				cursors[name]++
+			}
+		}
+
+		action := strategy.Hold
+
+		// Exit the positions first to free up cash for the new positions. A long position is
+		// exited by a Sell or a Short, and a short position is covered by a Sell or a Buy.
+		for _, name := range names {
+			bar, ok := current[name]
+			if !ok || bar.action == strategy.Hold {
+				continue
+			}
+
+			shares, ok := positions[name]
+			if !ok || (shares > 0 && bar.action == strategy.Buy) || (shares < 0 && bar.action == strategy.Short) {
+				continue
+			}
+
+			// Selling slips the price down, and covering slips the price up.
+			quantity := math.Abs(shares)
+			slippage := p.Costs.Slippage.Slippage(bar.snapshot, quantity)
+			price := bar.snapshot.Close - math.Copysign(slippage, shares)
+			commission := p.Costs.Commission.Commission(price, quantity)
+
+			cash += shares*price - commission
+			costs += commission + slippage*quantity
+			delete(positions, name)
+
+			result.Trades = append(result.Trades, &PortfolioTrade{
+				Date:       date,
+				Asset:      name,
+				Action:     strategy.Sell,
+				Shares:     quantity,
+				Price:      price,
+				Commission: commission,
+			})
+
+			action = strategy.Sell
+		}
+
+		for _, name := range names {
+			bar, ok := current[name]
+			if !ok || (bar.action != strategy.Buy && bar.action != strategy.Short) || bar.snapshot.Close <= 0 {
+				continue
+			}
+
+			_, ok = positions[name]
+			if ok {
+				continue
+			}
+
+			available := availableCash()
+
+			shares := p.Sizer.Size(&PositionRequest{
+				Asset:  name,
+				Price:  bar.snapshot.Close,
+				Atr:    bar.atr,
+				Equity: equity(),
+				Cash:   available,
+				Assets: len(names),
+			})
+
+			if shares <= 0 {
+				continue
+			}
+
+			// Buying slips the price up, and shorting slips the price down. The position
+			// is reduced to what the available cash can pay for along with the commission.
+			direction := 1.
+			if bar.action == strategy.Short {
+				direction = -1
+			}
+
+			slippage := p.Costs.Slippage.Slippage(bar.snapshot, shares)
+			price := bar.snapshot.Close + direction*slippage
+
+			shares = min(shares, (available-p.Costs.Commission.Commission(price, shares))/price)
+			if shares <= 0 {
+				continue
+			}
+
+			commission := p.Costs.Commission.Commission(price, shares)
+
+			cash -= direction*shares*price + commission
+			costs += commission + slippage*shares
+			positions[name] = direction * shares
+
+			result.Trades = append(result.Trades, &PortfolioTrade{
+				Date:       date,
+				Asset:      name,
+				Action:     bar.action,
+				Shares:     shares,
+				Price:      price,
+				Commission: commission,
+			})
+
+			action = bar.action
+		}
+
+		result.Equities = append(result.Equities, &PortfolioEquity{
+			Date:   date,
+			Cash:   cash,
+			Equity: equity(),
+			Costs:  costs,
+			Action: action,
+		})
+	}
+
+	return result
+}
+
+// writeReport writes the portfolio result to the report as a single asset
+// with a single strategy.
+func (p *Portfolio) writeReport(result *PortfolioResult) error {
+	portfolio := newPortfolioStrategy(fmt.Sprintf("%s %s (%s)", p.Name, p.Strategy.Name(), p.Sizer), result)
+	strategies := []strategy.Strategy{portfolio}
+
+	err := p.report.Begin([]string{p.Name}, strategies)
+	if err != nil {
+		return fmt.Errorf("unable to begin report: %w", err)
+	}
+
+	err = p.report.AssetBegin(p.Name, strategies)
+	if err != nil {
+		return fmt.Errorf("unable to begin asset: %w", err)
+	}
+
+	err = WriteWithCosts(
+		p.report,
+		p.Name,
+		portfolio,
+		helper.SliceToChan(portfolio.Snapshots()),
+		helper.Map(helper.SliceToChan(result.Equities), func(e *PortfolioEquity) strategy.Action {
+			return e.Action
+		}),
+		helper.Map(helper.SliceToChan(result.Equities), func(e *PortfolioEquity) float64 {
+			return e.Equity/result.InitialCash - 1
+		}),
+		helper.Map(helper.SliceToChan(result.Equities), func(e *PortfolioEquity) float64 {
+			return e.Costs / result.InitialCash
+		}),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to write report: %w", err)
+	}
+
+	err = p.report.AssetEnd(p.Name)
+	if err != nil {
+		return fmt.Errorf("unable to end asset: %w", err)
+	}
+
+	err = p.report.End()
+	if err != nil {
+		return fmt.Errorf("unable to end report: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package backtest
+
+import (
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/strategy"
+)
+
+// portfolioStrategy adapts the result of a portfolio backtest to the strategy
+// interface, so that its equity curve can be written by the reports.
+type portfolioStrategy struct {
+	// name is the name of the portfolio.
+	name string
+
+	// result is the portfolio result.
+	result *PortfolioResult
+
+	// equities is the equities of the portfolio by their dates.
+	equities map[int64]*PortfolioEquity
+}
+
+// newPortfolioStrategy function initializes a new portfolio strategy instance.
+func newPortfolioStrategy(name string, result *PortfolioResult) *portfolioStrategy {
+	equities := make(map[int64]*PortfolioEquity, len(result.Equities))
+
+	for _, equity := range result.Equities {
+		equities[equity.Date.UnixNano()] = equity
+	}
+
+	return &portfolioStrategy{
+		name:     name,
+		result:   result,
+		equities: equities,
+	}
+}
+
+// Snapshots returns the equity curve of the portfolio as snapshots.
+func (p *portfolioStrategy) Snapshots() []*asset.Snapshot {
+	snapshots := make([]*asset.Snapshot, len(p.result.Equities))
+
+	for i, equity := range p.result.Equities {
+		snapshots[i] = &asset.Snapshot{
+			Date:  equity.Date,
+			Open:  equity.Equity,
+			High:  equity.Equity,
+			Low:   equity.Equity,
+			Close: equity.Equity,
+		}
+	}
+
+	return snapshots
+}
+
+// Name returns the name of the strategy.
+func (p *portfolioStrategy) Name() string {
+	return p.name
+}
+
+// Compute processes the provided equity snapshots and generates the actions
+// taken by the portfolio on their dates.
+func (p *portfolioStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	return helper.Map(snapshots, func(snapshot *asset.Snapshot) strategy.Action {
+		return p.equity(snapshot).Action
+	})
+}
+
+// Report processes the provided equity snapshots and generates a report
+// of the equity curve annotated with the actions taken by the portfolio.
+func (p *portfolioStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	snapshots := helper.Duplicate(c, 5)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	equities := asset.SnapshotsAsClosings(snapshots[1])
+
+	cash := helper.Map(snapshots[2], func(snapshot *asset.Snapshot) float64 {
+		return p.equity(snapshot).Cash
+	})
+
+	// The portfolio may enter or exit multiple positions in a row.
+	annotations := helper.Map(p.Compute(snapshots[3]), strategy.Action.Annotation)
+
+	outcomes := helper.Map(snapshots[4], func(snapshot *asset.Snapshot) float64 {
+		return (snapshot.Close/p.result.InitialCash - 1) * 100
+	})
+
+	report := helper.NewReport(p.Name(), dates)
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Equity", equities))
+	report.AddColumn(helper.NewNumericReportColumn("Cash", cash))
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations))
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 1)
+
+	return report
+}
+
+// equity returns the portfolio equity for the given snapshot.
+func (p *portfolioStrategy) equity(snapshot *asset.Snapshot) *PortfolioEquity {
+	equity, ok := p.equities[snapshot.Date.UnixNano()]
+	if !ok {
+		return &PortfolioEquity{
+			Date:   snapshot.Date,
+			Equity: snapshot.Close,
+			Action: strategy.Hold,
+		}
+	}
+
+	return equity
+}
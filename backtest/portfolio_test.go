@@ -0,0 +1,291 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package backtest_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/backtest"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/strategy"
+)
+
+// newPortfolioRepository returns a repository with two assets whose prices
+// rise by ten percent over the last days.
+func newPortfolioRepository(t *testing.T) asset.Repository {
+	t.Helper()
+
+	repository := asset.NewInMemoryRepository()
+	date := time.Now().AddDate(0, 0, -5).Truncate(24 * time.Hour)
+
+	prices := map[string][]float64{
+		"a": {10, 10.5, 11},
+		"b": {20, 21, 22},
+	}
+
+	for name, closings := range prices {
+		snapshots := make([]*asset.Snapshot, len(closings))
+
+		for i, closing := range closings {
+			snapshots[i] = &asset.Snapshot{
+				Date:  date.AddDate(0, 0, i),
+				Open:  closing,
+				High:  closing + 1,
+				Low:   closing - 1,
+				Close: closing,
+			}
+		}
+
+		err := repository.Append(name, helper.SliceToChan(snapshots))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return repository
+}
+
+func TestPortfolio(t *testing.T) {
+	dataReport := backtest.NewDataReport()
+
+	portfolio := backtest.NewPortfolio(newPortfolioRepository(t), dataReport)
+	portfolio.InitialCash = 1000
+
+	result, err := portfolio.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Trades) != 2 {
+		t.Fatalf("actual %v expected %v", len(result.Trades), 2)
+	}
+
+	for _, trade := range result.Trades {
+		if trade.Action != strategy.Buy || trade.Shares*trade.Price != 500 {
+			t.Fatalf("actual %v %v expected %v %v", trade.Action, trade.Shares*trade.Price, strategy.Buy, 500)
+		}
+	}
+
+	if len(result.Equities) != 3 {
+		t.Fatalf("actual %v expected %v", len(result.Equities), 3)
+	}
+
+	last := result.Equities[len(result.Equities)-1]
+	if last.Cash != 0 || last.Equity != 1100 {
+		t.Fatalf("actual %v %v expected %v %v", last.Cash, last.Equity, 0, 1100)
+	}
+
+	results, ok := dataReport.Results[backtest.DefaultPortfolioName]
+	if !ok || len(results) != 1 {
+		t.Fatal("portfolio result not found")
+	}
+
+	if helper.RoundDigit(results[0].Outcome, 2) != 10 {
+		t.Fatalf("actual %v expected %v", results[0].Outcome, 10)
+	}
+}
+
+func TestPortfolioFixedFraction(t *testing.T) {
+	portfolio := backtest.NewPortfolio(newPortfolioRepository(t), backtest.NewDataReport())
+	portfolio.InitialCash = 1000
+	portfolio.Names = []string{"a"}
+	portfolio.Sizer = backtest.NewFixedFractionSizerWith(0.2)
+
+	result, err := portfolio.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	last := result.Equities[len(result.Equities)-1]
+	if last.Cash != 800 || last.Equity != 1020 {
+		t.Fatalf("actual %v %v expected %v %v", last.Cash, last.Equity, 800, 1020)
+	}
+}
+
+func TestPortfolioLastBars(t *testing.T) {
+	portfolio := backtest.NewPortfolio(newPortfolioRepository(t), backtest.NewDataReport())
+	portfolio.LastDays = 1
+	portfolio.LastBars = 2
+
+	result, err := portfolio.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Equities) != 2 {
+		t.Fatalf("actual %v expected %v", len(result.Equities), 2)
+	}
+}
+
+func TestPortfolioVolatilityTarget(t *testing.T) {
+	portfolio := backtest.NewPortfolio(newPortfolioRepository(t), backtest.NewDataReport())
+	portfolio.InitialCash = 1000
+	portfolio.Names = []string{"a"}
+	portfolio.Sizer = backtest.NewVolatilityTargetSizer()
+	portfolio.AtrPeriod = 1
+	portfolio.Strategy = &sequenceStrategy{
+		actions: []strategy.Action{strategy.Buy, strategy.Buy, strategy.Hold},
+	}
+
+	result, err := portfolio.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// ATR is not ready on the first day, and it is 2 on the second day, so
+	// 1000 * 0.01 / 2 = 5 shares.
+	if len(result.Trades) != 1 || result.Trades[0].Shares != 5 {
+		t.Fatalf("actual %v expected %v", result.Trades, 5)
+	}
+}
+
+func TestPortfolioSell(t *testing.T) {
+	portfolio := backtest.NewPortfolio(newPortfolioRepository(t), backtest.NewDataReport())
+	portfolio.InitialCash = 1000
+	portfolio.Names = []string{"a"}
+	portfolio.Strategy = &sequenceStrategy{
+		actions: []strategy.Action{strategy.Buy, strategy.Sell, strategy.Hold},
+	}
+
+	result, err := portfolio.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Trades) != 2 || result.Trades[1].Action != strategy.Sell {
+		t.Fatalf("actual %v expected %v", len(result.Trades), 2)
+	}
+
+	last := result.Equities[len(result.Equities)-1]
+	if last.Cash != 1050 || last.Equity != 1050 {
+		t.Fatalf("actual %v %v expected %v %v", last.Cash, last.Equity, 1050, 1050)
+	}
+}
+
+func TestPortfolioCosts(t *testing.T) {
+	portfolio := backtest.NewPortfolio(newPortfolioRepository(t), backtest.NewDataReport())
+	portfolio.InitialCash = 1000
+	portfolio.Names = []string{"a"}
+	portfolio.Costs = strategy.NewCostsWith(strategy.NewFixedCommissionWith(1), strategy.NewFixedSlippageWith(0))
+	portfolio.Strategy = &sequenceStrategy{
+		actions: []strategy.Action{strategy.Buy, strategy.Sell, strategy.Hold},
+	}
+
+	result, err := portfolio.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Buys 99.9 shares at 10 with the remaining cash after the commission,
+	// and sells them at 10.5.
+	if len(result.Trades) != 2 || helper.RoundDigit(result.Trades[0].Shares, 2) != 99.9 {
+		t.Fatalf("actual %v expected %v", result.Trades, 99.9)
+	}
+
+	last := result.Equities[len(result.Equities)-1]
+	if helper.RoundDigit(last.Cash, 2) != 1047.95 || last.Costs != 2 {
+		t.Fatalf("actual %v %v expected %v %v", last.Cash, last.Costs, 1047.95, 2)
+	}
+}
+
+func TestPortfolioHTMLReport(t *testing.T) {
+	outputDir, err := os.MkdirTemp("", "portfolio")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(outputDir)
+
+	portfolio := backtest.NewPortfolio(newPortfolioRepository(t), backtest.NewHTMLReport(outputDir))
+
+	_, err = portfolio.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPortfolioNonExistingAsset(t *testing.T) {
+	portfolio := backtest.NewPortfolio(newPortfolioRepository(t), backtest.NewDataReport())
+	portfolio.Names = []string{"a", "non_existing"}
+
+	result, err := portfolio.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Trades) != 1 {
+		t.Fatalf("actual %v expected %v", len(result.Trades), 1)
+	}
+}
+
+// sequenceStrategy recommends the given actions in order.
+type sequenceStrategy struct {
+	actions []strategy.Action
+}
+
+func (*sequenceStrategy) Name() string {
+	return "Sequence Strategy"
+}
+
+func (s *sequenceStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	go helper.Drain(snapshots)
+	return helper.SliceToChan(s.actions)
+}
+
+func (s *sequenceStrategy) Report(snapshots <-chan *asset.Snapshot) *helper.Report {
+	return helper.NewReport(s.Name(), asset.SnapshotsAsDates(snapshots))
+}
+
+func TestPortfolioShort(t *testing.T) {
+	portfolio := backtest.NewPortfolio(newPortfolioRepository(t), backtest.NewDataReport())
+	portfolio.InitialCash = 1000
+	portfolio.Names = []string{"a"}
+	portfolio.Strategy = &sequenceStrategy{
+		actions: []strategy.Action{strategy.Buy, strategy.Short, strategy.Hold},
+	}
+
+	result, err := portfolio.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Sells 100 shares at 10.5, and shorts 100 shares at 10.5 that rise to 11.
+	if len(result.Trades) != 3 || result.Trades[2].Action != strategy.Short || result.Trades[2].Shares != 100 {
+		t.Fatalf("actual %v expected a short of %v shares", result.Trades, 100)
+	}
+
+	last := result.Equities[len(result.Equities)-1]
+	if last.Cash != 2100 || last.Equity != 1000 {
+		t.Fatalf("actual %v %v expected %v %v", last.Cash, last.Equity, 2100, 1000)
+	}
+}
+
+func TestPortfolioShortCover(t *testing.T) {
+	portfolio := backtest.NewPortfolio(newPortfolioRepository(t), backtest.NewDataReport())
+	portfolio.InitialCash = 1000
+	portfolio.Names = []string{"a", "b"}
+	portfolio.Strategy = &sequenceStrategy{
+		actions: []strategy.Action{strategy.Short, strategy.Buy, strategy.Hold},
+	}
+
+	result, err := portfolio.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Shorts 50 shares of a at 10 and 25 shares of b at 20, covers them at 10.5 and 21,
+	// and enters the long positions with the remaining 950.
+	if len(result.Trades) != 6 {
+		t.Fatalf("actual %v expected %v", len(result.Trades), 6)
+	}
+
+	equity := result.Equities[1]
+	if equity.Equity != 950 || equity.Action != strategy.Buy {
+		t.Fatalf("actual %v %v expected %v %v", equity.Equity, equity.Action, 950, strategy.Buy)
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package backtest
+
+import (
+	"fmt"
+)
+
+const (
+	// DefaultFixedFraction is the default fraction of the equity allocated to each position.
+	DefaultFixedFraction = 0.1
+
+	// DefaultVolatilityTargetRisk is the default fraction of the equity risked per ATR move.
+	DefaultVolatilityTargetRisk = 0.01
+)
+
+// PositionRequest describes the state of the portfolio and the asset at the time
+// a strategy recommends entering a new position.
+type PositionRequest struct {
+	// Asset is the name of the asset.
+	Asset string
+
+	// Price is the price the position will be entered at.
+	Price float64
+
+	// Atr is the Average True Range of the asset. It is zero until the
+	// ATR passes its idle period.
+	Atr float64
+
+	// Equity is the total value of the portfolio, including the cash.
+	Equity float64
+
+	// Cash is the available cash of the portfolio.
+	Cash float64
+
+	// Assets is the number of assets in the portfolio.
+	Assets int
+}
+
+// PositionSizer determines the size of a new position.
+type PositionSizer interface {
+	// Size returns the number of shares to buy for the given request. The
+	// portfolio caps the size by the available cash.
+	Size(request *PositionRequest) float64
+
+	// String is the string representation of the position sizer.
+	String() string
+}
+
+// FixedFractionSizer allocates a fixed fraction of the portfolio equity
+// to each new position.
+//
+//	Shares = Equity * Fraction / Price
+type FixedFractionSizer struct {
+	// Fraction is the fraction of the equity for each position.
+	Fraction float64
+}
+
+// NewFixedFractionSizer function initializes a new fixed fraction sizer instance with the default parameters.
+func NewFixedFractionSizer() *FixedFractionSizer {
+	return NewFixedFractionSizerWith(DefaultFixedFraction)
+}
+
+// NewFixedFractionSizerWith function initializes a new fixed fraction sizer instance with the given parameters.
+func NewFixedFractionSizerWith(fraction float64) *FixedFractionSizer {
+	return &FixedFractionSizer{
+		Fraction: fraction,
+	}
+}
+
+// Size returns the number of shares to buy for the given request.
+func (f *FixedFractionSizer) Size(request *PositionRequest) float64 {
+	return request.Equity * f.Fraction / request.Price
+}
+
+// String is the string representation of the fixed fraction sizer.
+func (f *FixedFractionSizer) String() string {
+	return fmt.Sprintf("Fixed Fraction(%.2f)", f.Fraction)
+}
+
+// EqualWeightSizer allocates an equal share of the portfolio equity
+// to each asset in the portfolio.
+//
+//	Shares = Equity / Assets / Price
+type EqualWeightSizer struct {
+}
+
+// NewEqualWeightSizer function initializes a new equal weight sizer instance.
+func NewEqualWeightSizer() *EqualWeightSizer {
+	return &EqualWeightSizer{}
+}
+
+// Size returns the number of shares to buy for the given request.
+func (*EqualWeightSizer) Size(request *PositionRequest) float64 {
+	if request.Assets == 0 {
+		return 0
+	}
+
+	return request.Equity / float64(request.Assets) / request.Price
+}
+
+// String is the string representation of the equal weight sizer.
+func (*EqualWeightSizer) String() string {
+	return "Equal Weight"
+}
+
+// VolatilityTargetSizer sizes each new position so that a move of one Average
+// True Range (ATR) changes the portfolio equity by the given risk fraction. No
+// position is entered until the ATR passes its idle period.
+//
+//	Shares = Equity * Risk / ATR
+type VolatilityTargetSizer struct {
+	// Risk is the fraction of the equity risked per ATR move.
+	Risk float64
+}
+
+// NewVolatilityTargetSizer function initializes a new volatility target sizer instance with the default parameters.
+func NewVolatilityTargetSizer() *VolatilityTargetSizer {
+	return NewVolatilityTargetSizerWith(DefaultVolatilityTargetRisk)
+}
+
+// NewVolatilityTargetSizerWith function initializes a new volatility target sizer instance with the given parameters.
+func NewVolatilityTargetSizerWith(risk float64) *VolatilityTargetSizer {
+	return &VolatilityTargetSizer{
+		Risk: risk,
+	}
+}
+
+// Size returns the number of shares to buy for the given request.
+func (v *VolatilityTargetSizer) Size(request *PositionRequest) float64 {
+	if request.Atr <= 0 {
+		return 0
+	}
+
+	return request.Equity * v.Risk / request.Atr
+}
+
+// String is the string representation of the volatility target sizer.
+func (v *VolatilityTargetSizer) String() string {
+	return fmt.Sprintf("Volatility Target(%.2f)", v.Risk)
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package backtest_test
+
+import (
+	"testing"
+
+	"github.com/miromax42/indicator/v2/backtest"
+)
+
+func TestFixedFractionSizer(t *testing.T) {
+	sizer := backtest.NewFixedFractionSizerWith(0.25)
+
+	actual := sizer.Size(&backtest.PositionRequest{
+		Price:  10,
+		Equity: 1000,
+	})
+
+	if actual != 25 {
+		t.Fatalf("actual %v expected %v", actual, 25)
+	}
+
+	if sizer.String() != "Fixed Fraction(0.25)" {
+		t.Fatalf("actual %v", sizer.String())
+	}
+}
+
+func TestEqualWeightSizer(t *testing.T) {
+	sizer := backtest.NewEqualWeightSizer()
+
+	actual := sizer.Size(&backtest.PositionRequest{
+		Price:  10,
+		Equity: 1000,
+		Assets: 4,
+	})
+
+	if actual != 25 {
+		t.Fatalf("actual %v expected %v", actual, 25)
+	}
+
+	actual = sizer.Size(&backtest.PositionRequest{
+		Price:  10,
+		Equity: 1000,
+	})
+
+	if actual != 0 {
+		t.Fatalf("actual %v expected %v", actual, 0)
+	}
+}
+
+func TestVolatilityTargetSizer(t *testing.T) {
+	sizer := backtest.NewVolatilityTargetSizerWith(0.02)
+
+	actual := sizer.Size(&backtest.PositionRequest{
+		Price:  10,
+		Atr:    4,
+		Equity: 1000,
+	})
+
+	if actual != 5 {
+		t.Fatalf("actual %v expected %v", actual, 5)
+	}
+
+	actual = sizer.Size(&backtest.PositionRequest{
+		Price:  10,
+		Equity: 1000,
+	})
+
+	if actual != 0 {
+		t.Fatalf("actual %v expected %v", actual, 0)
+	}
+}
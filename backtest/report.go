@@ -6,6 +6,7 @@ package backtest
 
 import (
 	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
 	"github.com/miromax42/indicator/v2/strategy"
 )
 
@@ -26,3 +27,36 @@ type Report interface {
 	// End is called when the backtest ends.
 	End() error
 }
+
+// CostReport is a backtest report that also records the transaction costs.
+type CostReport interface {
+	Report
+
+	// WriteWithCosts writes the given strategy actions, outcomes, and cumulative transaction costs to the report.
+	WriteWithCosts(assetName string, currentStrategy strategy.Strategy, snapshots <-chan *asset.Snapshot, actions <-chan strategy.Action, outcomes <-chan float64, costs <-chan float64) error
+}
+
+// WriteWithCosts writes the given strategy actions, outcomes, and cumulative transaction costs
+// to the report. For reports that do not implement the CostReport interface, the costs are
+// drained and the remaining values are written.
+func WriteWithCosts(report Report, assetName string, currentStrategy strategy.Strategy, snapshots <-chan *asset.Snapshot, actions <-chan strategy.Action, outcomes <-chan float64, costs <-chan float64) error {
+	costReport, ok := report.(CostReport)
+	if ok {
+		return costReport.WriteWithCosts(assetName, currentStrategy, snapshots, actions, outcomes, costs)
+	}
+
+	go helper.Drain(costs)
+
+	return report.Write(assetName, currentStrategy, snapshots, actions, outcomes)
+}
+
+// withoutCosts duplicates the given outcomes along with a channel of zero costs.
+func withoutCosts(outcomes <-chan float64) (<-chan float64, <-chan float64) {
+	outcomesSplice := helper.Duplicate(outcomes, 2)
+
+	costs := helper.Map(outcomesSplice[1], func(float64) float64 {
+		return 0
+	})
+
+	return outcomesSplice[0], costs
+}
@@ -11,6 +11,12 @@ import (
 const (
 	// HTMLReportBuilderName is the name for the HTML report builder.
 	HTMLReportBuilderName = "html"
+
+	// JSONReportBuilderName is the name for the JSON report builder.
+	JSONReportBuilderName = "json"
+
+	// CSVReportBuilderName is the name for the CSV report builder.
+	CSVReportBuilderName = "csv"
 )
 
 // ReportBuilderFunc defines a function to build a new report using the given configuration parameter.
@@ -19,6 +25,8 @@ type ReportBuilderFunc func(config string) (Report, error)
 // reportBuilders provides mapping for the report builders.
 var reportBuilders = map[string]ReportBuilderFunc{
 	HTMLReportBuilderName: htmlReportBuilder,
+	JSONReportBuilderName: jsonReportBuilder,
+	CSVReportBuilderName:  csvReportBuilder,
 }
 
 // RegisterReportBuilder registers the given builder.
@@ -40,3 +48,13 @@ func NewReport(name, config string) (Report, error) {
 func htmlReportBuilder(config string) (Report, error) {
 	return NewHTMLReport(config), nil
 }
+
+// jsonReportBuilder builds a new JSON report instance.
+func jsonReportBuilder(config string) (Report, error) {
+	return NewJSONReport(config), nil
+}
+
+// csvReportBuilder builds a new CSV report instance.
+func csvReportBuilder(config string) (Report, error) {
+	return NewCSVReport(config), nil
+}
@@ -51,3 +51,27 @@ func TestNewReportMemory(t *testing.T) {
 		t.Fatalf("report not correct type: %T", report)
 	}
 }
+
+func TestNewReportJSON(t *testing.T) {
+	report, err := backtest.NewReport(backtest.JSONReportBuilderName, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, ok := report.(*backtest.JSONReport)
+	if !ok {
+		t.Fatalf("report not correct type: %T", report)
+	}
+}
+
+func TestNewReportCSV(t *testing.T) {
+	report, err := backtest.NewReport(backtest.CSVReportBuilderName, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, ok := report.(*backtest.CSVReport)
+	if !ok {
+		t.Fatalf("report not correct type: %T", report)
+	}
+}
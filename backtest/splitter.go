@@ -0,0 +1,160 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package backtest
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// DefaultWalkForwardInSample is the default number of snapshots in each in-sample window.
+	DefaultWalkForwardInSample = 252
+
+	// DefaultWalkForwardOutOfSample is the default number of snapshots in each out-of-sample window.
+	DefaultWalkForwardOutOfSample = 63
+
+	// DefaultTrainTestRatio is the default ratio of the snapshots in the in-sample window.
+	DefaultTrainTestRatio = 0.7
+)
+
+// Window is a pair of consecutive in-sample and out-of-sample windows, given as
+// indexes into the snapshots of an asset.
+type Window struct {
+	// InSampleBegin is the index of the first in-sample snapshot.
+	InSampleBegin int
+
+	// OutOfSampleBegin is the index of the first out-of-sample snapshot.
+	OutOfSampleBegin int
+
+	// End is the index following the last out-of-sample snapshot.
+	End int
+}
+
+// Splitter splits the snapshots of an asset into in-sample and out-of-sample windows.
+type Splitter interface {
+	// Split returns the windows for the given number of snapshots. The
+	// out-of-sample windows are expected to be consecutive.
+	Split(n int) []*Window
+
+	// MinSnapshots returns the minimum number of snapshots that the splitter
+	// needs for a single window.
+	MinSnapshots() int
+
+	// String is the string representation of the splitter.
+	String() string
+}
+
+// WalkForwardSplitter splits the snapshots into rolling windows. Each window has
+// the given number of in-sample snapshots followed by the given number of
+// out-of-sample snapshots, and the next window rolls forward by the number of
+// out-of-sample snapshots. The trailing snapshots that do not fill a window are
+// not used.
+type WalkForwardSplitter struct {
+	// InSample is the number of snapshots in each in-sample window.
+	InSample int
+
+	// OutOfSample is the number of snapshots in each out-of-sample window.
+	OutOfSample int
+}
+
+// NewWalkForwardSplitter function initializes a new walk forward splitter instance with the default parameters.
+func NewWalkForwardSplitter() *WalkForwardSplitter {
+	return NewWalkForwardSplitterWith(DefaultWalkForwardInSample, DefaultWalkForwardOutOfSample)
+}
+
+// NewWalkForwardSplitterWith function initializes a new walk forward splitter instance with the given parameters.
+func NewWalkForwardSplitterWith(inSample, outOfSample int) *WalkForwardSplitter {
+	return &WalkForwardSplitter{
+		InSample:    inSample,
+		OutOfSample: outOfSample,
+	}
+}
+
+// Split returns the windows for the given number of snapshots.
+func (w *WalkForwardSplitter) Split(n int) []*Window {
+	windows := []*Window{}
+
+	if w.InSample <= 0 || w.OutOfSample <= 0 {
+		return windows
+	}
+
+	for begin := 0; begin+w.InSample+w.OutOfSample <= n; begin += w.OutOfSample {
+		windows = append(windows, &Window{
+			InSampleBegin:    begin,
+			OutOfSampleBegin: begin + w.InSample,
+			End:              begin + w.InSample + w.OutOfSample,
+		})
+	}
+
+	return windows
+}
+
+// MinSnapshots returns the number of snapshots in a single window.
+func (w *WalkForwardSplitter) MinSnapshots() int {
+	return w.InSample + w.OutOfSample
+}
+
+// String is the string representation of the walk forward splitter.
+func (w *WalkForwardSplitter) String() string {
+	return fmt.Sprintf("Walk Forward(%d, %d)", w.InSample, w.OutOfSample)
+}
+
+// TrainTestSplitter splits the snapshots into a single in-sample window with the
+// given ratio of the snapshots, followed by an out-of-sample window with the
+// remaining snapshots.
+type TrainTestSplitter struct {
+	// Ratio is the ratio of the snapshots in the in-sample window.
+	Ratio float64
+}
+
+// NewTrainTestSplitter function initializes a new train test splitter instance with the default parameters.
+func NewTrainTestSplitter() *TrainTestSplitter {
+	return NewTrainTestSplitterWith(DefaultTrainTestRatio)
+}
+
+// NewTrainTestSplitterWith function initializes a new train test splitter instance with the given parameters.
+func NewTrainTestSplitterWith(ratio float64) *TrainTestSplitter {
+	return &TrainTestSplitter{
+		Ratio: ratio,
+	}
+}
+
+// Split returns the window for the given number of snapshots.
+func (t *TrainTestSplitter) Split(n int) []*Window {
+	inSample := int(float64(n) * t.Ratio)
+
+	if inSample <= 0 || inSample >= n {
+		return []*Window{}
+	}
+
+	return []*Window{
+		{
+			InSampleBegin:    0,
+			OutOfSampleBegin: inSample,
+			End:              n,
+		},
+	}
+}
+
+// MinSnapshots returns the minimum number of snapshots that have at least one snapshot
+// in both of the in-sample and the out-of-sample windows.
+func (t *TrainTestSplitter) MinSnapshots() int {
+	if t.Ratio <= 0 || t.Ratio >= 1 {
+		return 0
+	}
+
+	n := max(2, int(math.Ceil(1/t.Ratio)))
+	if int(float64(n)*t.Ratio) <= 0 {
+		n++
+	}
+
+	return n
+}
+
+// String is the string representation of the train test splitter.
+func (t *TrainTestSplitter) String() string {
+	return fmt.Sprintf("Train Test(%.2f)", t.Ratio)
+}
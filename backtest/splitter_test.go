@@ -0,0 +1,90 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package backtest_test
+
+import (
+	"testing"
+
+	"github.com/miromax42/indicator/v2/backtest"
+)
+
+func TestWalkForwardSplitter(t *testing.T) {
+	splitter := backtest.NewWalkForwardSplitterWith(4, 2)
+
+	windows := splitter.Split(11)
+
+	expected := []backtest.Window{
+		{InSampleBegin: 0, OutOfSampleBegin: 4, End: 6},
+		{InSampleBegin: 2, OutOfSampleBegin: 6, End: 8},
+		{InSampleBegin: 4, OutOfSampleBegin: 8, End: 10},
+	}
+
+	if len(windows) != len(expected) {
+		t.Fatalf("actual %v expected %v", len(windows), len(expected))
+	}
+
+	for i, window := range windows {
+		if *window != expected[i] {
+			t.Fatalf("actual %v expected %v", *window, expected[i])
+		}
+	}
+}
+
+func TestWalkForwardSplitterNotEnough(t *testing.T) {
+	splitter := backtest.NewWalkForwardSplitter()
+
+	windows := splitter.Split(100)
+	if len(windows) != 0 {
+		t.Fatalf("actual %v expected %v", len(windows), 0)
+	}
+
+	if splitter.String() != "Walk Forward(252, 63)" {
+		t.Fatalf("actual %v", splitter.String())
+	}
+}
+
+func TestTrainTestSplitter(t *testing.T) {
+	splitter := backtest.NewTrainTestSplitter()
+
+	windows := splitter.Split(10)
+
+	expected := backtest.Window{InSampleBegin: 0, OutOfSampleBegin: 7, End: 10}
+
+	if len(windows) != 1 || *windows[0] != expected {
+		t.Fatalf("actual %v expected %v", windows, expected)
+	}
+
+	windows = splitter.Split(1)
+	if len(windows) != 0 {
+		t.Fatalf("actual %v expected %v", len(windows), 0)
+	}
+
+	if splitter.String() != "Train Test(0.70)" {
+		t.Fatalf("actual %v", splitter.String())
+	}
+}
+
+func TestSplitterMinSnapshots(t *testing.T) {
+	tests := []struct {
+		splitter backtest.Splitter
+		expected int
+	}{
+		{splitter: backtest.NewWalkForwardSplitterWith(4, 2), expected: 6},
+		{splitter: backtest.NewTrainTestSplitterWith(0.7), expected: 2},
+		{splitter: backtest.NewTrainTestSplitterWith(0.3), expected: 4},
+		{splitter: backtest.NewTrainTestSplitterWith(1), expected: 0},
+	}
+
+	for _, test := range tests {
+		actual := test.splitter.MinSnapshots()
+		if actual != test.expected {
+			t.Fatalf("%s actual %v expected %v", test.splitter, actual, test.expected)
+		}
+
+		if actual > 0 && len(test.splitter.Split(actual)) != 1 {
+			t.Fatalf("%s expected a window for %v snapshots", test.splitter, actual)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package backtest
+
+import (
+	"fmt"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/strategy"
+)
+
+// walkForward backtests the strategies on the in-sample and out-of-sample windows of the
+// given asset snapshots, and on the out-of-sample windows combined. The actions for each
+// window are computed over both of its in-sample and out-of-sample snapshots, so that the
+// strategies are past their idle periods in the out-of-sample window.
+func (b *Backtest) walkForward(name string, snapshots []*asset.Snapshot) {
+	windows := b.Splitter.Split(len(snapshots))
+	if len(windows) == 0 {
+		b.Logger.Error("Not enough snapshots for the splitter.", "asset", name, "splitter", b.Splitter, "snapshots", len(snapshots))
+		return
+	}
+
+	outOfSampleActions := make([][]strategy.Action, len(b.Strategies))
+
+	for i, window := range windows {
+		windowSnapshots := snapshots[window.InSampleBegin:window.End]
+		split := window.OutOfSampleBegin - window.InSampleBegin
+
+		inSampleActions := make([][]strategy.Action, len(b.Strategies))
+		windowOutOfSampleActions := make([][]strategy.Action, len(b.Strategies))
+
+		for j, currentStrategy := range b.Strategies {
+			actions := helper.ChanToSlice(currentStrategy.Compute(helper.SliceToChan(windowSnapshots)))
+
+			// Keep the actions aligned with the snapshots.
+			for len(actions) < len(windowSnapshots) {
+				actions = append(actions, strategy.Hold)
+			}
+
+			inSampleActions[j] = actions[:split]
+			position := lastPosition(inSampleActions[j])
+
+			windowOutOfSampleActions[j] = carryPosition(strategy.Sell, position, actions[split:len(windowSnapshots)])
+			outOfSampleActions[j] = append(
+				outOfSampleActions[j],
+				carryPosition(lastPosition(outOfSampleActions[j]), position, actions[split:len(windowSnapshots)])...,
+			)
+		}
+
+		b.writeAsset(
+			fmt.Sprintf("%s (In-Sample %d)", name, i+1),
+			snapshots[window.InSampleBegin:window.OutOfSampleBegin],
+			inSampleActions,
+		)
+
+		b.writeAsset(
+			fmt.Sprintf("%s (Out-of-Sample %d)", name, i+1),
+			snapshots[window.OutOfSampleBegin:window.End],
+			windowOutOfSampleActions,
+		)
+	}
+
+	b.writeAsset(
+		fmt.Sprintf("%s (Out-of-Sample)", name),
+		snapshots[windows[0].OutOfSampleBegin:windows[len(windows)-1].End],
+		outOfSampleActions,
+	)
+}
+
+// writeAsset writes the given actions of each strategy on the given snapshots to the
+// report under the given asset name.
+func (b *Backtest) writeAsset(name string, snapshots []*asset.Snapshot, actions [][]strategy.Action) {
+	err := b.report.AssetBegin(name, b.Strategies)
+	if err != nil {
+		b.Logger.Error("Unable to begin asset.", "asset", name, "error", err)
+		return
+	}
+
+	for i, currentStrategy := range b.Strategies {
+		outcomes, costs := strategy.OutcomeWithCosts(helper.SliceToChan(snapshots), helper.SliceToChan(actions[i]), b.Costs)
+
+		err = WriteWithCosts(b.report, name, currentStrategy, helper.SliceToChan(snapshots), helper.SliceToChan(actions[i]), outcomes, costs)
+		if err != nil {
+			b.Logger.Error("Unable to write report.", "asset", name, "error", err)
+		}
+	}
+
+	err = b.report.AssetEnd(name)
+	if err != nil {
+		b.Logger.Error("Unable to end asset.", "asset", name, "error", err)
+	}
+}
+
+// carryPosition carries the position held at the end of the in-sample actions into the
+// out-of-sample actions, which follow the given current position. A leading Hold is
+// replaced with the in-sample position when the two positions differ.
+func carryPosition(current, inSample strategy.Action, outOfSample []strategy.Action) []strategy.Action {
+	result := make([]strategy.Action, len(outOfSample))
+	copy(result, outOfSample)
+
+	if len(result) > 0 && result[0] == strategy.Hold && current != inSample {
+		result[0] = inSample
+	}
+
+	return result
+}
+
+// lastPosition returns the position at the end of the given actions as the last Buy,
+// Sell, or Short action. It returns Sell when no position is held.
+func lastPosition(actions []strategy.Action) strategy.Action {
+	for i := len(actions) - 1; i >= 0; i-- {
+		if actions[i] != strategy.Hold {
+			return actions[i]
+		}
+	}
+
+	return strategy.Sell
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package backtest_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/backtest"
+	"github.com/miromax42/indicator/v2/strategy"
+	"github.com/miromax42/indicator/v2/strategy/trend"
+)
+
+func TestBacktestWalkForward(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/repository")
+
+	dataReport := backtest.NewDataReport()
+	backtester := backtest.NewBacktest(repository, dataReport)
+	backtester.Names = append(backtester.Names, "brk-b")
+	backtester.Strategies = append(backtester.Strategies, trend.NewApoStrategy(), strategy.NewBuyAndHoldStrategy())
+	backtester.LastDays = 10000
+	backtester.Splitter = backtest.NewWalkForwardSplitterWith(100, 50)
+
+	err := backtester.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]int{
+		"brk-b (In-Sample 1)":     100,
+		"brk-b (Out-of-Sample 1)": 50,
+		"brk-b (In-Sample 3)":     100,
+		"brk-b (Out-of-Sample 3)": 50,
+		"brk-b (Out-of-Sample)":   150,
+	}
+
+	for name, length := range expected {
+		results, ok := dataReport.Results[name]
+		if !ok || len(results) != 2 {
+			t.Fatalf("results not found for %s", name)
+		}
+
+		if len(results[0].Transactions) != length {
+			t.Fatalf("actual %v expected %v", len(results[0].Transactions), length)
+		}
+	}
+
+	_, ok := dataReport.Results["brk-b (In-Sample 4)"]
+	if ok {
+		t.Fatal("unexpected window")
+	}
+
+	// The buy and hold position is carried into the out-of-sample windows.
+	for _, name := range []string{"brk-b (Out-of-Sample 2)", "brk-b (Out-of-Sample)"} {
+		actions := dataReport.Results[name][1].Transactions
+		if actions[0] != strategy.Buy {
+			t.Fatalf("actual %v expected %v", actions[0], strategy.Buy)
+		}
+	}
+
+	// The combined out-of-sample actions only enter the position once.
+	actions := dataReport.Results["brk-b (Out-of-Sample)"][1].Transactions
+	for i := 1; i < len(actions); i++ {
+		if actions[i] != strategy.Hold {
+			t.Fatalf("actual %v expected %v at %d", actions[i], strategy.Hold, i)
+		}
+	}
+}
+
+func TestBacktestTrainTestHTMLReport(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/repository")
+
+	outputDir, err := os.MkdirTemp("", "backtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(outputDir)
+
+	backtester := backtest.NewBacktest(repository, backtest.NewHTMLReport(outputDir))
+	backtester.Names = append(backtester.Names, "brk-b")
+	backtester.LastDays = 10000
+	backtester.Splitter = backtest.NewTrainTestSplitter()
+
+	err = backtester.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBacktestWalkForwardNotEnoughSnapshots(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/repository")
+
+	dataReport := backtest.NewDataReport()
+	backtester := backtest.NewBacktest(repository, dataReport)
+	backtester.Names = append(backtester.Names, "brk-b")
+	backtester.LastDays = 10000
+	backtester.Splitter = backtest.NewWalkForwardSplitter()
+
+	err := backtester.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(dataReport.Results) != 0 {
+		t.Fatalf("actual %v expected %v", len(dataReport.Results), 0)
+	}
+}
+
+func TestBacktestWalkForwardRaisesLookback(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/repository")
+
+	// The default last days do not cover the test data, so the lookback is raised to a single window.
+	dataReport := backtest.NewDataReport()
+	backtester := backtest.NewBacktest(repository, dataReport)
+	backtester.Names = append(backtester.Names, "brk-b")
+	backtester.Splitter = backtest.NewWalkForwardSplitterWith(100, 50)
+
+	err := backtester.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, ok := dataReport.Results["brk-b (Out-of-Sample 1)"]
+	if !ok || len(results[0].Transactions) != 50 {
+		t.Fatal("out-of-sample window not found")
+	}
+
+	_, ok = dataReport.Results["brk-b (In-Sample 2)"]
+	if ok {
+		t.Fatal("unexpected window")
+	}
+}
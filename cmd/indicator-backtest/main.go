@@ -10,14 +10,17 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"time"
 
 	"github.com/miromax42/indicator/v2/asset"
 	"github.com/miromax42/indicator/v2/backtest"
 	"github.com/miromax42/indicator/v2/strategy"
 	"github.com/miromax42/indicator/v2/strategy/compound"
 	"github.com/miromax42/indicator/v2/strategy/momentum"
+	"github.com/miromax42/indicator/v2/strategy/pattern"
 	"github.com/miromax42/indicator/v2/strategy/trend"
 	"github.com/miromax42/indicator/v2/strategy/volatility"
+	"github.com/miromax42/indicator/v2/strategy/volume"
 )
 
 func main() {
@@ -25,10 +28,18 @@ func main() {
 	var repositoryConfig string
 	var reportName string
 	var reportConfig string
+	var writeBars bool
 	var workers int
 	var lastDays int
+	var lastDuration time.Duration
+	var lastBars int
+	var timeframe string
 	var addSplits bool
 	var addAnds bool
+	var commissionPercent float64
+	var slippageBps float64
+	var inSample int
+	var outOfSample int
 
 	fmt.Fprintln(os.Stderr, "Indicator Backtest")
 	fmt.Fprintln(os.Stderr, "Copyright (c) 2021-2024 Onur Cinar.")
@@ -39,11 +50,19 @@ func main() {
 	flag.StringVar(&repositoryName, "repository-name", "filesystem", "repository name")
 	flag.StringVar(&repositoryConfig, "repository-config", "", "repository config")
 	flag.StringVar(&reportName, "report-name", "html", "report name")
-	flag.StringVar(&reportConfig, "report-config", ".", "report type")
+	flag.StringVar(&reportConfig, "report-config", ".", "report config")
+	flag.BoolVar(&writeBars, "bars", false, "write the per-bar actions and outcomes with the json and csv reports")
 	flag.IntVar(&workers, "workers", backtest.DefaultBacktestWorkers, "number of concurrent workers")
 	flag.IntVar(&lastDays, "last", backtest.DefaultLastDays, "number of days to do backtest")
+	flag.DurationVar(&lastDuration, "last-duration", 0, "duration to do backtest, such as 6h, overrides the number of days")
+	flag.IntVar(&lastBars, "last-bars", 0, "number of most recent bars to do backtest, overriding the last days")
+	flag.StringVar(&timeframe, "timeframe", "", "timeframe to resample the snapshots into, such as 1h, 1w, or 1mo")
 	flag.BoolVar(&addSplits, "splits", false, "add the split strategies")
 	flag.BoolVar(&addAnds, "ands", false, "add the and strategies")
+	flag.Float64Var(&commissionPercent, "commission-percent", 0, "commission percent of the trade value")
+	flag.Float64Var(&slippageBps, "slippage-bps", 0, "slippage in basis points of the price")
+	flag.IntVar(&inSample, "in-sample", 0, "number of in-sample bars for the walk forward mode")
+	flag.IntVar(&outOfSample, "out-of-sample", 0, "number of out-of-sample bars for the walk forward mode")
 	flag.Parse()
 
 	logger := slog.Default()
@@ -54,22 +73,54 @@ func main() {
 		os.Exit(1)
 	}
 
-	report, err := backtest.NewReport(repositoryName, repositoryConfig)
+	report, err := backtest.NewReport(reportName, reportConfig)
 	if err != nil {
 		logger.Error("Unable to initialize report.", "error", err)
 		os.Exit(1)
 	}
 
+	if writeBars {
+		switch fileReport := report.(type) {
+		case *backtest.JSONReport:
+			fileReport.WriteBars = true
+		case *backtest.CSVReport:
+			fileReport.WriteBars = true
+		default:
+			logger.Warn("Report does not write bars.", "report", reportName)
+		}
+	}
+
 	backtester := backtest.NewBacktest(source, report)
 	backtester.Workers = workers
 	backtester.LastDays = lastDays
+	backtester.LastDuration = lastDuration
+	backtester.LastBars = lastBars
+	backtester.Costs = strategy.NewCostsWith(
+		strategy.NewPercentageCommissionWith(commissionPercent),
+		strategy.NewFixedSlippageWith(slippageBps),
+	)
 	backtester.Logger = logger
+
+	if timeframe != "" {
+		backtester.Resampler, err = asset.ParseResampler(timeframe)
+		if err != nil {
+			logger.Error("Unable to parse timeframe.", "error", err)
+			os.Exit(1)
+		}
+	}
+
+	if inSample > 0 && outOfSample > 0 {
+		backtester.Splitter = backtest.NewWalkForwardSplitterWith(inSample, outOfSample)
+	}
+
 	backtester.Names = append(backtester.Names, flag.Args()...)
 	backtester.Strategies = append(backtester.Strategies, compound.AllStrategies()...)
 	backtester.Strategies = append(backtester.Strategies, momentum.AllStrategies()...)
+	backtester.Strategies = append(backtester.Strategies, pattern.AllStrategies()...)
 	backtester.Strategies = append(backtester.Strategies, strategy.AllStrategies()...)
 	backtester.Strategies = append(backtester.Strategies, trend.AllStrategies()...)
 	backtester.Strategies = append(backtester.Strategies, volatility.AllStrategies()...)
+	backtester.Strategies = append(backtester.Strategies, volume.AllStrategies()...)
 
 	if addSplits {
 		backtester.Strategies = append(backtester.Strategies, strategy.AllSplitStrategies(backtester.Strategies)...)
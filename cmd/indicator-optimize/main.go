@@ -0,0 +1,297 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+// main is the indicator optimize command line program.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/backtest"
+	"github.com/miromax42/indicator/v2/optimizer"
+	"github.com/miromax42/indicator/v2/strategy"
+	"github.com/miromax42/indicator/v2/strategy/compound"
+	"github.com/miromax42/indicator/v2/strategy/momentum"
+	"github.com/miromax42/indicator/v2/strategy/trend"
+	"github.com/miromax42/indicator/v2/strategy/volatility"
+	volatilityIndicator "github.com/miromax42/indicator/v2/volatility"
+)
+
+// searchSpace is a strategy constructor with its default parameter ranges.
+type searchSpace struct {
+	parameters  []*optimizer.Parameter
+	constructor optimizer.Constructor
+}
+
+// searchSpaces are the strategies that can be optimized.
+var searchSpaces = map[string]func() *searchSpace{
+	"golden-cross": func() *searchSpace {
+		return &searchSpace{
+			parameters: []*optimizer.Parameter{
+				optimizer.NewParameter("fast", 20, 60, 10),
+				optimizer.NewParameter("slow", 100, 200, 25),
+			},
+			constructor: func(values []float64) strategy.Strategy {
+				return trend.NewGoldenCrossStrategyWith(int(values[0]), int(values[1]))
+			},
+		}
+	},
+	"macd": func() *searchSpace {
+		return &searchSpace{
+			parameters: []*optimizer.Parameter{
+				optimizer.NewParameter("period1", 8, 16, 2),
+				optimizer.NewParameter("period2", 20, 32, 3),
+				optimizer.NewParameter("period3", 5, 13, 2),
+			},
+			constructor: func(values []float64) strategy.Strategy {
+				return trend.NewMacdStrategyWith(int(values[0]), int(values[1]), int(values[2]))
+			},
+		}
+	},
+	"macd-rsi": func() *searchSpace {
+		return &searchSpace{
+			parameters: []*optimizer.Parameter{
+				optimizer.NewParameter("buyAt", 20, 40, 5),
+				optimizer.NewParameter("sellAt", 60, 80, 5),
+			},
+			constructor: func(values []float64) strategy.Strategy {
+				return compound.NewMacdRsiStrategyWith(values[0], values[1])
+			},
+		}
+	},
+	"rsi": func() *searchSpace {
+		return &searchSpace{
+			parameters: []*optimizer.Parameter{
+				optimizer.NewParameter("buyAt", 20, 40, 5),
+				optimizer.NewParameter("sellAt", 60, 80, 5),
+			},
+			constructor: func(values []float64) strategy.Strategy {
+				return momentum.NewRsiStrategyWith(values[0], values[1])
+			},
+		}
+	},
+	"super-trend": func() *searchSpace {
+		return &searchSpace{
+			parameters: []*optimizer.Parameter{
+				optimizer.NewParameter("period", 7, 21, 7),
+				optimizer.NewParameter("multiplier", 1.5, 3.5, 0.5),
+			},
+			constructor: func(values []float64) strategy.Strategy {
+				return volatility.NewSuperTrendStrategyWith(
+					volatilityIndicator.NewSuperTrendWithPeriod[float64](int(values[0]), values[1]),
+				)
+			},
+		}
+	},
+	"triple-rsi": func() *searchSpace {
+		return &searchSpace{
+			parameters: []*optimizer.Parameter{
+				optimizer.NewParameter("period", 3, 7, 2),
+				optimizer.NewParameter("downDays", 2, 4, 1),
+				optimizer.NewParameter("buyAt", 20, 40, 5),
+				optimizer.NewParameter("sellAt", 50, 70, 10),
+			},
+			constructor: func(values []float64) strategy.Strategy {
+				return momentum.NewTripleRsiStrategyWith(
+					int(values[0]),
+					momentum.DefaultTripleRsiStrategyMovingAveragePeriod,
+					int(values[1]),
+					momentum.DefaultTripleRsiStrategyBuySignalAt,
+					values[2],
+					values[3],
+				)
+			},
+		}
+	},
+}
+
+// objectives are the objectives the results can be ranked by.
+var objectives = map[string]optimizer.Objective{
+	"outcome": optimizer.ByOutcome,
+	"sharpe":  optimizer.BySharpe,
+	"sortino": optimizer.BySortino,
+	"calmar":  optimizer.ByCalmar,
+}
+
+// parameterFlags is the repeatable flag overriding the parameter ranges.
+type parameterFlags []*optimizer.Parameter
+
+// String is the string representation of the parameters.
+func (p *parameterFlags) String() string {
+	values := make([]string, len(*p))
+
+	for i, parameter := range *p {
+		values[i] = parameter.String()
+	}
+
+	return strings.Join(values, ",")
+}
+
+// Set parses the given name=min:max:step value.
+func (p *parameterFlags) Set(value string) error {
+	name, valueRange, ok := strings.Cut(value, "=")
+	if !ok {
+		return fmt.Errorf("expected name=min:max:step but got %s", value)
+	}
+
+	fields := strings.Split(valueRange, ":")
+	if len(fields) != 3 {
+		return fmt.Errorf("expected min:max:step but got %s", valueRange)
+	}
+
+	numbers := make([]float64, len(fields))
+
+	for i, field := range fields {
+		number, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return fmt.Errorf("unable to parse %s: %w", field, err)
+		}
+
+		numbers[i] = number
+	}
+
+	*p = append(*p, optimizer.NewParameter(name, numbers[0], numbers[1], numbers[2]))
+	return nil
+}
+
+func main() {
+	var repositoryName string
+	var repositoryConfig string
+	var strategyName string
+	var objectiveName string
+	var workers int
+	var lastDays int
+	var lastDuration time.Duration
+	var lastBars int
+	var timeframe string
+	var top int
+	var overrides parameterFlags
+
+	fmt.Fprintln(os.Stderr, "Indicator Optimize")
+	fmt.Fprintln(os.Stderr, "Copyright (c) 2021-2024 Onur Cinar.")
+	fmt.Fprintln(os.Stderr, "The source code is provided under GNU AGPLv3 License.")
+	fmt.Fprintln(os.Stderr, "https://github.com/cinar/indicator")
+	fmt.Fprintln(os.Stderr)
+
+	flag.StringVar(&repositoryName, "repository-name", "filesystem", "repository name")
+	flag.StringVar(&repositoryConfig, "repository-config", "", "repository config")
+	flag.StringVar(&strategyName, "strategy", "rsi", "strategy name ("+strings.Join(sortedKeys(searchSpaces), ", ")+")")
+	flag.StringVar(&objectiveName, "objective", "sharpe", "objective name ("+strings.Join(sortedKeys(objectives), ", ")+")")
+	flag.IntVar(&workers, "workers", backtest.DefaultBacktestWorkers, "number of concurrent workers")
+	flag.IntVar(&lastDays, "last", backtest.DefaultLastDays, "number of days to do backtest")
+	flag.DurationVar(&lastDuration, "last-duration", 0, "duration to do backtest, such as 6h, overrides the number of days")
+	flag.IntVar(&lastBars, "last-bars", 0, "number of most recent bars to do backtest, overriding the last days")
+	flag.StringVar(&timeframe, "timeframe", "", "timeframe to resample the snapshots into, such as 1h, 1w, or 1mo")
+	flag.IntVar(&top, "top", 10, "number of top results to print")
+	flag.Var(&overrides, "param", "parameter range as name=min:max:step")
+	flag.Parse()
+
+	logger := slog.Default()
+
+	newSearchSpace, ok := searchSpaces[strategyName]
+	if !ok {
+		logger.Error("Unknown strategy.", "strategy", strategyName)
+		os.Exit(1)
+	}
+
+	objective, ok := objectives[objectiveName]
+	if !ok {
+		logger.Error("Unknown objective.", "objective", objectiveName)
+		os.Exit(1)
+	}
+
+	space := newSearchSpace()
+
+	for _, override := range overrides {
+		i := slices.IndexFunc(space.parameters, func(p *optimizer.Parameter) bool {
+			return p.Name == override.Name
+		})
+
+		if i == -1 {
+			logger.Error("Unknown parameter.", "strategy", strategyName, "parameter", override.Name)
+			os.Exit(1)
+		}
+
+		space.parameters[i] = override
+	}
+
+	source, err := asset.NewRepository(repositoryName, repositoryConfig)
+	if err != nil {
+		logger.Error("Unable to initialize source.", "error", err)
+		os.Exit(1)
+	}
+
+	gridSearch := optimizer.NewGridSearch(source, space.parameters, space.constructor)
+	gridSearch.Names = append(gridSearch.Names, flag.Args()...)
+	gridSearch.Workers = workers
+	gridSearch.LastDays = lastDays
+	gridSearch.LastDuration = lastDuration
+	gridSearch.LastBars = lastBars
+	gridSearch.Objective = objective
+	gridSearch.Logger = logger
+
+	if timeframe != "" {
+		gridSearch.Resampler, err = asset.ParseResampler(timeframe)
+		if err != nil {
+			logger.Error("Unable to parse timeframe.", "error", err)
+			os.Exit(1)
+		}
+	}
+
+	results, err := gridSearch.Run()
+	if err != nil {
+		logger.Error("Unable to run optimizer.", "error", err)
+		os.Exit(1)
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+
+	for _, parameter := range space.parameters {
+		fmt.Fprintf(writer, "%s\t", parameter.Name)
+	}
+
+	fmt.Fprintln(writer, "Outcome\tSharpe\tSortino\tCalmar\tMax Drawdown\tTrades\t")
+
+	for _, result := range results[:min(top, len(results))] {
+		for _, value := range result.Values {
+			fmt.Fprintf(writer, "%g\t", value)
+		}
+
+		fmt.Fprintf(writer, "%.2f%%\t%.2f\t%.2f\t%.2f\t%.2f%%\t%d\t\n",
+			result.Outcome,
+			result.Metrics.Sharpe,
+			result.Metrics.Sortino,
+			result.Metrics.Calmar,
+			result.Metrics.MaxDrawdown*100,
+			result.Metrics.Trades,
+		)
+	}
+
+	err = writer.Flush()
+	if err != nil {
+		logger.Error("Unable to write results.", "error", err)
+		os.Exit(1)
+	}
+}
+
+// sortedKeys returns the sorted keys of the given map.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+	return keys
+}
@@ -8,6 +8,7 @@ package main
 import (
 	"flag"
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"time"
@@ -75,4 +76,18 @@ func main() {
 		logger.Error("Unable to sync repositories.", "error", err)
 		os.Exit(1)
 	}
+
+	// Close the repositories backed by databases, such as the Bolt repository.
+	for _, repository := range []asset.Repository{source, target} {
+		closer, ok := repository.(io.Closer)
+		if !ok {
+			continue
+		}
+
+		err = closer.Close()
+		if err != nil {
+			logger.Error("Unable to close repository.", "error", err)
+			os.Exit(1)
+		}
+	}
 }
@@ -1,3 +1,10 @@
 module github.com/miromax42/indicator/v2
 
 go 1.22
+
+require (
+	github.com/klauspost/compress v1.18.0
+	go.etcd.io/bbolt v1.3.11
+)
+
+require golang.org/x/sys v0.29.0 // indirect
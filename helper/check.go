@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"runtime"
+	"time"
 )
 
 // CheckEquals determines whether the two channels are equal.
@@ -39,3 +41,19 @@ func CheckEquals[T comparable](inputs ...<-chan T) error {
 		i++
 	}
 }
+
+// CheckNoGoroutineLeak waits up to a second for the number of goroutines to go back
+// to the given count, such as the count before a pipeline is started and cancelled.
+func CheckNoGoroutineLeak(count int) error {
+	deadline := time.Now().Add(time.Second)
+
+	for runtime.NumGoroutine() > count {
+		if time.Now().After(deadline) {
+			return fmt.Errorf("actual %d goroutines expected %d", runtime.NumGoroutine(), count)
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import "context"
+
+// ComputeWithContext applies the given compute function, such as the Compute method
+// of an indicator, to the input channel and ties the resulting pipeline to the given
+// context. Once the context is done, the input of the pipeline is closed, and the
+// returned channel is closed after the pipeline is drained. The producer of the input
+// channel must observe the same context to terminate.
+//
+// Example:
+//
+//	sma := trend.NewSmaWithPeriod[float64](10)
+//	smas := helper.ComputeWithContext(ctx, closings, sma.Compute)
+func ComputeWithContext[F, T any](ctx context.Context, c <-chan F, compute func(<-chan F) <-chan T) <-chan T {
+	return OutputWithContext(ctx, compute(WithContext(ctx, c)))
+}
+
+// Compute2WithContext applies the given compute function with two inputs, such as the
+// Compute method of the OBV indicator, to the input channels and ties the resulting
+// pipeline to the given context, similar to ComputeWithContext.
+//
+// Example:
+//
+//	obv := volume.NewObv[float64]()
+//	obvs := helper.Compute2WithContext(ctx, closings, volumes, obv.Compute)
+func Compute2WithContext[A, B, T any](ctx context.Context, a <-chan A, b <-chan B, compute func(<-chan A, <-chan B) <-chan T) <-chan T {
+	return OutputWithContext(ctx, compute(WithContext(ctx, a), WithContext(ctx, b)))
+}
+
+// Compute3WithContext applies the given compute function with three inputs, such as the
+// Compute method of the ATR indicator, to the input channels and ties the resulting
+// pipeline to the given context, similar to ComputeWithContext.
+//
+// Example:
+//
+//	atr := volatility.NewAtr[float64]()
+//	atrs := helper.Compute3WithContext(ctx, highs, lows, closings, atr.Compute)
+func Compute3WithContext[A, B, C, T any](ctx context.Context, a <-chan A, b <-chan B, c <-chan C, compute func(<-chan A, <-chan B, <-chan C) <-chan T) <-chan T {
+	return OutputWithContext(ctx, compute(WithContext(ctx, a), WithContext(ctx, b), WithContext(ctx, c)))
+}
+
+// Compute4WithContext applies the given compute function with four inputs, such as the
+// Compute method of the Chaikin Money Flow indicator, to the input channels and ties the
+// resulting pipeline to the given context, similar to ComputeWithContext. The indicators
+// with multiple outputs can be tied to the context by wrapping their inputs with
+// WithContext and their outputs with OutputWithContext.
+//
+// Example:
+//
+//	cmf := volume.NewCmf[float64]()
+//	cmfs := helper.Compute4WithContext(ctx, highs, lows, closings, volumes, cmf.Compute)
+func Compute4WithContext[A, B, C, D, T any](ctx context.Context, a <-chan A, b <-chan B, c <-chan C, d <-chan D, compute func(<-chan A, <-chan B, <-chan C, <-chan D) <-chan T) <-chan T {
+	return OutputWithContext(ctx, compute(WithContext(ctx, a), WithContext(ctx, b), WithContext(ctx, c), WithContext(ctx, d)))
+}
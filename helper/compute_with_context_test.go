@@ -0,0 +1,95 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"context"
+	"runtime"
+	"testing"
+
+	"github.com/miromax42/indicator/v2/helper"
+)
+
+func TestComputeWithContext(t *testing.T) {
+	input := helper.SliceToChan([]int{2, 4, 6, 8})
+	expected := helper.SliceToChan([]int{12, 16})
+
+	actual := helper.ComputeWithContext(context.Background(), input, func(c <-chan int) <-chan int {
+		return helper.Skip(helper.MultiplyBy(c, 2), 2)
+	})
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestComputeWithContextCancel(t *testing.T) {
+	goroutines := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	compute := func(c <-chan int) <-chan int {
+		cs := helper.Duplicate(c, 2)
+		return helper.Add(cs[0], helper.Shift(cs[1], 1, 0))
+	}
+
+	actual := helper.ComputeWithContext(ctx, liveFeed(ctx), compute)
+
+	<-actual
+	cancel()
+
+	err := helper.CheckNoGoroutineLeak(goroutines)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCompute3WithContextCancel(t *testing.T) {
+	goroutines := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	compute := func(a, b, c <-chan int) <-chan int {
+		return helper.Add(helper.Add(a, b), c)
+	}
+
+	actual := helper.Compute3WithContext(ctx, liveFeed(ctx), liveFeed(ctx), liveFeed(ctx), compute)
+
+	<-actual
+	cancel()
+
+	err := helper.CheckNoGoroutineLeak(goroutines)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCompute2WithContext(t *testing.T) {
+	expected := helper.SliceToChan([]int{3, 6})
+
+	actual := helper.Compute2WithContext(context.Background(), helper.SliceToChan([]int{1, 2}), helper.SliceToChan([]int{2, 4}), helper.Add[int])
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCompute4WithContext(t *testing.T) {
+	expected := helper.SliceToChan([]int{4, 8})
+
+	compute := func(a, b, c, d <-chan int) <-chan int {
+		return helper.Add(helper.Add(a, b), helper.Add(c, d))
+	}
+
+	actual := helper.Compute4WithContext(context.Background(), helper.SliceToChan([]int{1, 2}),
+		helper.SliceToChan([]int{1, 2}), helper.SliceToChan([]int{1, 2}), helper.SliceToChan([]int{1, 2}), compute)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -7,6 +7,7 @@ package helper
 import (
 	"encoding/csv"
 	"errors"
+	"fmt"
 	"io"
 	"io/fs"
 	"log/slog"
@@ -23,6 +24,10 @@ const (
 	// CsvFormatTag represents the parameter name for the column format.
 	CsvFormatTag = "format"
 
+	// CsvFormatSeparator separates the alternative formats of a date and time column. The values are
+	// read using the first matching format, and written using the first format preserving them.
+	CsvFormatSeparator = "|"
+
 	// DefaultDateTimeFormat denotes the default format of a date and time column.
 	DefaultDateTimeFormat = "2006-01-02 15:04:05"
 )
@@ -91,57 +96,52 @@ func NewCsv[T any](hasHeader bool) (*Csv[T], error) {
 
 // ReadFromReader parses the CSV data from the provided reader,
 // maps the data to corresponding struct fields, and delivers
-// the resulting it through the channel.
+// the resulting rows through the channel.
 func (c *Csv[T]) ReadFromReader(reader io.Reader) <-chan *T {
 	rows := make(chan *T)
 
 	go func() {
 		defer close(rows)
 
-		csvReader := csv.NewReader(reader)
-
-		// If CSV has headers, align column indices to match the
-		// order of column headers.
-		if c.hasHeader {
-			err := c.updateColumnIndexes(csvReader)
-			if err != nil {
-				c.Logger.Error("Unable to update the column indexes.", "error", err)
-				return
-			}
+		err := c.readFromReader(reader, rows)
+		if err != nil {
+			c.Logger.Error("Unable to read CSV.", "error", err)
 		}
+	}()
 
-		for {
-			record, err := csvReader.Read()
-			if err == io.EOF {
-				break
-			}
-
-			if err != nil {
-				c.Logger.Error("Unable to read row.", "error", err)
-				break
-			}
-
-			row := new(T)
-			rowValue := reflect.ValueOf(row).Elem()
+	return rows
+}
 
-			for _, column := range c.columns {
-				if column.ColumnIndex == -1 {
-					continue
-				}
+// ReadFromReaderWithError parses the CSV data from the provided reader, maps the
+// data to corresponding struct fields, and delivers the resulting rows through the
+// rows channel. Unlike ReadFromReader, any error that ends the reading early is
+// delivered through the errors channel, which is closed after the rows channel,
+// so that a partial read can be told apart from a complete one.
+//
+// Example:
+//
+//	rows, errs := csv.ReadFromReaderWithError(reader)
+//	result := helper.ChanToSlice(rows)
+//
+//	err := <-errs
+//	if err != nil {
+//		return err
+//	}
+func (c *Csv[T]) ReadFromReaderWithError(reader io.Reader) (<-chan *T, <-chan error) {
+	rows := make(chan *T)
+	errs := make(chan error, 1)
 
-				err := setReflectValue(rowValue.Field(column.FieldIndex),
-					record[column.ColumnIndex], column.Format)
-				if err != nil {
-					c.Logger.Error("Unable to set value.", "error", err)
-					return
-				}
-			}
+	go func() {
+		defer close(errs)
+		defer close(rows)
 
-			rows <- row
+		err := c.readFromReader(reader, rows)
+		if err != nil {
+			errs <- err
 		}
 	}()
 
-	return rows
+	return rows, errs
 }
 
 // ReadFromFile parses the CSV data from the provided file name,
@@ -167,6 +167,33 @@ func (c *Csv[T]) ReadFromFile(fileName string) (<-chan *T, error) {
 	return rows, nil
 }
 
+// ReadFromFileWithError parses the CSV data from the provided file name, maps the
+// data to corresponding struct fields, and delivers the resulting rows through the
+// rows channel. Any error that ends the reading early is delivered through the
+// errors channel, which is closed after the rows channel.
+func (c *Csv[T]) ReadFromFileWithError(fileName string) (<-chan *T, <-chan error, error) {
+	file, err := os.Open(filepath.Clean(fileName))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make(chan *T)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(rows)
+		defer CloseAndLogErrorWithLogger(file, "Unable to close file.", c.Logger)
+
+		err := c.readFromReader(file, rows)
+		if err != nil {
+			errs <- err
+		}
+	}()
+
+	return rows, errs, nil
+}
+
 // AppendToFile appends the provided rows of data to the end of the specified file, creating
 // the file if it doesn't exist.  In append mode, the function assumes that the existing
 // file's column order matches the field order of the given row struct to ensure consistent
@@ -201,6 +228,50 @@ func (c *Csv[T]) WriteToFile(fileName string, rows <-chan *T) error {
 	return file.Close()
 }
 
+// readFromReader parses the CSV data from the provided reader, maps the data to
+// corresponding struct fields, and sends the resulting rows to the given channel.
+// It returns the error that ended the reading early, if any.
+func (c *Csv[T]) readFromReader(reader io.Reader, rows chan<- *T) error {
+	csvReader := csv.NewReader(reader)
+
+	// If CSV has headers, align column indices to match the
+	// order of column headers.
+	if c.hasHeader {
+		err := c.updateColumnIndexes(csvReader)
+		if err != nil {
+			return fmt.Errorf("unable to update the column indexes: %w", err)
+		}
+	}
+
+	for {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("unable to read row: %w", err)
+		}
+
+		row := new(T)
+		rowValue := reflect.ValueOf(row).Elem()
+
+		for _, column := range c.columns {
+			if column.ColumnIndex == -1 {
+				continue
+			}
+
+			err := setReflectValue(rowValue.Field(column.FieldIndex),
+				record[column.ColumnIndex], column.Format)
+			if err != nil {
+				return fmt.Errorf("unable to set value: %w", err)
+			}
+		}
+
+		rows <- row
+	}
+}
+
 // updateColumnIndexes aligns column indices to match the order of column headers.
 func (c *Csv[T]) updateColumnIndexes(csvReader *csv.Reader) error {
 	headers, err := csvReader.Read()
@@ -284,6 +355,19 @@ func ReadFromCsvFile[T any](fileName string, hasHeader bool) (<-chan *T, error)
 	return csv.ReadFromFile(fileName)
 }
 
+// ReadFromCsvFileWithError creates a CSV instance, parses CSV data from the provided
+// filename, maps the data to corresponding struct fields, and delivers it through the
+// rows channel. Any error that ends the reading early is delivered through the errors
+// channel, which is closed after the rows channel.
+func ReadFromCsvFileWithError[T any](fileName string, hasHeader bool) (<-chan *T, <-chan error, error) {
+	csv, err := NewCsv[T](hasHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return csv.ReadFromFileWithError(fileName)
+}
+
 // AppendOrWriteToCsvFile writes the provided rows of data to the specified file, appending to
 // the existing file if it exists or creating a new one if it doesn't. In append mode, the
 // function assumes that the existing file's column order matches the field order of the
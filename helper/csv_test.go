@@ -392,3 +392,128 @@ func TestAppendOrWriteToCsvFileNoStruct(t *testing.T) {
 		t.Fatal("expected error")
 	}
 }
+
+func TestCsvReadFromReaderWithError(t *testing.T) {
+	type Row struct {
+		Close float64
+		High  float64
+	}
+
+	reader := strings.NewReader("Close,High\n10.2,30.4\n20.1,40.3\n")
+
+	csv, err := helper.NewCsv[Row](true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, errs := csv.ReadFromReaderWithError(reader)
+
+	actual := helper.ChanToSlice(rows)
+	if len(actual) != 2 {
+		t.Fatalf("actual %d rows expected 2", len(actual))
+	}
+
+	err = <-errs
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCsvReadFromReaderWithErrorInvalidField(t *testing.T) {
+	type Row struct {
+		Close float64
+		High  float64
+	}
+
+	reader := strings.NewReader("Close,High\n10.2,30.4\n\"ABCD\",40.3\n30.1,50.2\n")
+
+	csv, err := helper.NewCsv[Row](true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, errs := csv.ReadFromReaderWithError(reader)
+
+	actual := helper.ChanToSlice(rows)
+	if len(actual) != 1 {
+		t.Fatalf("actual %d rows expected 1", len(actual))
+	}
+
+	err = <-errs
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestCsvReadFromReaderWithErrorMissingHeader(t *testing.T) {
+	type Row struct {
+		Close float64
+		High  float64
+	}
+
+	csv, err := helper.NewCsv[Row](true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, errs := csv.ReadFromReaderWithError(strings.NewReader(""))
+
+	row, ok := <-rows
+	if ok {
+		t.Fatalf("actual %v expected closed", row)
+	}
+
+	err = <-errs
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestReadFromCsvFileWithError(t *testing.T) {
+	type Row struct {
+		Close float64
+		High  float64
+	}
+
+	rows, errs, err := helper.ReadFromCsvFileWithError[Row]("testdata/with_header.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	row := <-rows
+
+	if row.Close != 30.4 {
+		t.Fatalf("actual %v expected 30.4", row.Close)
+	}
+
+	helper.Drain(rows)
+
+	err = <-errs
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadFromCsvFileWithErrorMissingFile(t *testing.T) {
+	type Row struct {
+		Close float64
+		High  float64
+	}
+
+	_, _, err := helper.ReadFromCsvFileWithError[Row]("testdata/missing.csv", true)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestReadFromCsvFileWithErrorNoStruct(t *testing.T) {
+	type Row struct {
+		Close float64
+		High  float64
+	}
+
+	_, _, err := helper.ReadFromCsvFileWithError[*Row]("testdata/with_header.csv", true)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -4,6 +4,8 @@
 
 package helper
 
+import "context"
+
 // Duplicate duplicates a given receive-only channel by reading each value coming out of
 // that channel and sending them on requested number of new output channels.
 //
@@ -38,3 +40,47 @@ func Duplicate[T any](input <-chan T, count int) []<-chan T {
 
 	return result
 }
+
+// DuplicateWithContext duplicates a given receive-only channel by reading each value
+// coming out of that channel and sending them on requested number of new output
+// channels until the context is done. Once the context is done, all output channels
+// are closed, so that a single consumer cancelling the context tears down the fan-out
+// for all consumers. The producer of the input channel must observe the same context
+// to terminate.
+//
+// Example:
+//
+//	outputs := helper.DuplicateWithContext(ctx, helper.SliceToChanWithContext(ctx, expected), 2)
+//
+//	fmt.Println(<-outputs[0]) // -10
+//	cancel()
+func DuplicateWithContext[T any](ctx context.Context, input <-chan T, count int) []<-chan T {
+	outputs := make([]chan T, count)
+	result := make([]<-chan T, count)
+
+	for i := range outputs {
+		outputs[i] = make(chan T, cap(input))
+		result[i] = outputs[i]
+	}
+
+	go func() {
+		for _, output := range outputs {
+			defer close(output)
+		}
+
+		for {
+			n, ok := receiveWithContext(ctx, input)
+			if !ok {
+				return
+			}
+
+			for _, output := range outputs {
+				if !sendWithContext(ctx, output, n) {
+					return
+				}
+			}
+		}
+	}()
+
+	return result
+}
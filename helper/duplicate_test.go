@@ -5,6 +5,8 @@
 package helper_test
 
 import (
+	"context"
+	"runtime"
 	"testing"
 
 	"github.com/miromax42/indicator/v2/helper"
@@ -24,3 +26,31 @@ func TestDuplicate(t *testing.T) {
 		}
 	}
 }
+
+func TestDuplicateWithContext(t *testing.T) {
+	expecteds := []float64{-10, 20, -4, -5}
+
+	outputs := helper.DuplicateWithContext(context.Background(), helper.SliceToChan(expecteds), 2)
+
+	err := helper.CheckEquals(outputs[0], helper.SliceToChan(expecteds), outputs[1], helper.SliceToChan(expecteds))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDuplicateWithContextCancel(t *testing.T) {
+	goroutines := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	outputs := helper.DuplicateWithContext(ctx, liveFeed(ctx), 3)
+
+	// Only the first consumer reads, and the others are abandoned.
+	<-outputs[0]
+	cancel()
+
+	err := helper.CheckNoGoroutineLeak(goroutines)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import "fmt"
+
+// intervalReportColumn is the interval report column struct.
+type intervalReportColumn[T Number] struct {
+	ReportColumn
+	name   string
+	values <-chan T
+}
+
+// NewIntervalReportColumn returns a new instance of an interval column for a report. Interval
+// columns belong to the data column added before them, and the area between the intervals of
+// the same data column is shaded on the chart.
+func NewIntervalReportColumn[T Number](name string, values <-chan T) ReportColumn {
+	return &intervalReportColumn[T]{
+		name:   name,
+		values: values,
+	}
+}
+
+// Name returns the name of the report column.
+func (c *intervalReportColumn[T]) Name() string {
+	return c.name
+}
+
+// Type returns number as the data type.
+func (*intervalReportColumn[T]) Type() string {
+	return "number"
+}
+
+// Role returns the role of the report column.
+func (*intervalReportColumn[T]) Role() string {
+	return "interval"
+}
+
+// Value returns the next data value for the report column.
+func (c *intervalReportColumn[T]) Value() string {
+	return fmt.Sprintf("%v", <-c.values)
+}
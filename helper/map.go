@@ -4,6 +4,8 @@
 
 package helper
 
+import "context"
+
 // Map applies the given transformation function to each element in the
 // input channel and returns a new channel containing the transformed
 // values. The transformation function takes a float64 value as input
@@ -27,3 +29,34 @@ func Map[F, T any](c <-chan F, f func(F) T) <-chan T {
 
 	return mc
 }
+
+// MapWithContext applies the given transformation function to each element in the
+// input channel until the context is done, and returns a new channel containing the
+// transformed values. Once the context is done, the returned channel is closed. The
+// producer of the input channel must observe the same context to terminate.
+//
+// Example:
+//
+//	timesTwo := helper.MapWithContext(ctx, c, func(n int) int {
+//		return n * 2
+//	})
+func MapWithContext[F, T any](ctx context.Context, c <-chan F, f func(F) T) <-chan T {
+	mc := make(chan T)
+
+	go func() {
+		defer close(mc)
+
+		for {
+			n, ok := receiveWithContext(ctx, c)
+			if !ok {
+				break
+			}
+
+			if !sendWithContext(ctx, mc, f(n)) {
+				break
+			}
+		}
+	}()
+
+	return mc
+}
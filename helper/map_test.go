@@ -5,6 +5,8 @@
 package helper_test
 
 import (
+	"context"
+	"runtime"
 	"testing"
 
 	"github.com/miromax42/indicator/v2/helper"
@@ -32,3 +34,35 @@ func TestMap(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestMapWithContext(t *testing.T) {
+	input := helper.SliceToChan([]int{2, 4, 6, 8})
+	expected := helper.SliceToChan([]int{4, 8, 12, 16})
+
+	actual := helper.MapWithContext(context.Background(), input, func(n int) int {
+		return n * 2
+	})
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMapWithContextCancel(t *testing.T) {
+	goroutines := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	actual := helper.MapWithContext(ctx, liveFeed(ctx), func(n int) int {
+		return n * 2
+	})
+
+	<-actual
+	cancel()
+
+	err := helper.CheckNoGoroutineLeak(goroutines)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -4,6 +4,8 @@
 
 package helper
 
+import "context"
+
 // Operate applies the provided operate function to corresponding values from two
 // numeric input channels and sends the resulting values to an output channel.
 //
@@ -37,3 +39,41 @@ func Operate[A any, B any, R any](ac <-chan A, bc <-chan B, o func(A, B) R) <-ch
 
 	return oc
 }
+
+// OperateWithContext applies the provided operate function to corresponding values from
+// two input channels until the context is done, and sends the resulting values to an
+// output channel. Once the context is done, the output channel is closed. The producers
+// of the input channels must observe the same context to terminate.
+//
+// Example:
+//
+//	add := helper.OperateWithContext(ctx, ac, bc, func(a, b int) int {
+//	  return a + b
+//	})
+func OperateWithContext[A any, B any, R any](ctx context.Context, ac <-chan A, bc <-chan B, o func(A, B) R) <-chan R {
+	oc := make(chan R)
+
+	go func() {
+		defer close(oc)
+
+		for {
+			an, ok := receiveWithContext(ctx, ac)
+			if !ok {
+				drainWithContext(ctx, bc)
+				break
+			}
+
+			bn, ok := receiveWithContext(ctx, bc)
+			if !ok {
+				drainWithContext(ctx, ac)
+				break
+			}
+
+			if !sendWithContext(ctx, oc, o(an, bn)) {
+				break
+			}
+		}
+	}()
+
+	return oc
+}
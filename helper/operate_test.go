@@ -5,6 +5,8 @@
 package helper_test
 
 import (
+	"context"
+	"runtime"
 	"testing"
 
 	"github.com/miromax42/indicator/v2/helper"
@@ -57,3 +59,39 @@ func TestOperateSecondEnds(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestOperateWithContext(t *testing.T) {
+	ac := helper.SliceToChan([]int{1, 2, 3, 4, 5})
+	bc := helper.SliceToChan([]int{1, 2, 3, 4, 5, 6, 7, 8})
+
+	expected := helper.SliceToChan([]int{2, 4, 6, 8, 10})
+
+	actual := helper.OperateWithContext(context.Background(), ac, bc, func(a, b int) int {
+		return a + b
+	})
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOperateWithContextCancel(t *testing.T) {
+	goroutines := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	cs := helper.DuplicateWithContext(ctx, liveFeed(ctx), 2)
+
+	actual := helper.OperateWithContext(ctx, cs[0], cs[1], func(a, b int) int {
+		return a + b
+	})
+
+	<-actual
+	cancel()
+
+	err := helper.CheckNoGoroutineLeak(goroutines)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
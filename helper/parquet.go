@@ -0,0 +1,572 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/miromax42/indicator/v2/internal/parquet"
+)
+
+const (
+	// ParquetColumnTag represents the parameter name for the column name.
+	ParquetColumnTag = "parquet"
+
+	// DefaultParquetRowGroupSize is the default number of rows in each row group.
+	DefaultParquetRowGroupSize = 1000
+)
+
+// parquetColumn represents the mapping between the Parquet column and
+// the corresponding struct field.
+type parquetColumn struct {
+	Name       string
+	FieldIndex int
+	Type       parquet.Type
+}
+
+// Parquet represents the configuration for Parquet reader and writer. Each struct field is stored as
+// a column, where the time fields are timestamps in milliseconds, the float fields are doubles, the
+// integer fields are 64-bit integers, and the string fields are UTF-8 strings. The files are read
+// using the columns matching the struct fields by their names, the case-sensitive match preferred,
+// and the remaining columns are ignored. The flat columns with the plain and dictionary encodings
+// in version 1 and 2 data pages, and the Snappy, Gzip, and Zstd codecs are supported for reading.
+// The nested columns, and the other codecs, such as LZ4 and Brotli, are not supported. The files
+// are written uncompressed using the plain encoding, along with the statistics of each row group.
+type Parquet[T any] struct {
+	// columns are the mappings between the Parquet columns and
+	// the corresponding struct fields.
+	columns []parquetColumn
+
+	// RowGroupSize is the number of rows in each row group written.
+	RowGroupSize int
+
+	// Logger is the slog logger instance.
+	Logger *slog.Logger
+}
+
+// NewParquet function initializes a new Parquet instance.
+func NewParquet[T any]() (*Parquet[T], error) {
+	p := &Parquet[T]{
+		RowGroupSize: DefaultParquetRowGroupSize,
+		Logger:       slog.Default(),
+	}
+
+	// Row type must be a pointer to struct.
+	structType := reflect.TypeOf((*T)(nil)).Elem()
+	if structType.Kind() != reflect.Struct {
+		return nil, errors.New("type not a struct")
+	}
+
+	// Create a mapping linking Parquet columns to corresponding struct fields.
+	p.columns = make([]parquetColumn, structType.NumField())
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+
+		name, ok := field.Tag.Lookup(ParquetColumnTag)
+		if !ok {
+			name = field.Name
+		}
+
+		physicalType, err := parquetTypeOf(field.Type)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+
+		p.columns[i] = parquetColumn{
+			Name:       name,
+			FieldIndex: i,
+			Type:       physicalType,
+		}
+	}
+
+	return p, nil
+}
+
+// ReadFromFile parses the Parquet data from the provided file name,
+// maps the data to corresponding struct fields, and delivers
+// the resulting rows through the channel.
+func (p *Parquet[T]) ReadFromFile(fileName string) (<-chan *T, error) {
+	rows, errs, err := p.ReadFromFileWithError(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		err := <-errs
+		if err != nil {
+			p.Logger.Error("Unable to read Parquet.", "error", err)
+		}
+	}()
+
+	return rows, nil
+}
+
+// ReadFromFileWithError parses the Parquet data from the provided file name, maps the
+// data to corresponding struct fields, and delivers the resulting rows through the
+// rows channel. Any error that ends the reading early is delivered through the
+// errors channel, which is closed after the rows channel.
+func (p *Parquet[T]) ReadFromFileWithError(fileName string) (<-chan *T, <-chan error, error) {
+	return p.readFromFile(fileName, -1, time.Time{})
+}
+
+// ReadFromFileSince parses the Parquet data from the provided file name, and delivers the
+// rows with the given time column since the given date through the rows channel. The row
+// groups ending before the given date are skipped based on their column statistics without
+// being read. Any error that ends the reading early is delivered through the errors channel,
+// which is closed after the rows channel.
+func (p *Parquet[T]) ReadFromFileSince(fileName, column string, since time.Time) (<-chan *T, <-chan error, error) {
+	for i, c := range p.columns {
+		if c.Name == column && p.fieldType(i) == reflect.TypeOf(time.Time{}) {
+			return p.readFromFile(fileName, i, since)
+		}
+	}
+
+	return nil, nil, fmt.Errorf("time column %s not found", column)
+}
+
+// ReadLastFromFile parses the Parquet data from the provided file name, and returns its last row
+// by reading only the last row group. It returns nil if the file has no rows.
+func (p *Parquet[T]) ReadLastFromFile(fileName string) (*T, error) {
+	file, parquetFile, err := p.open(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	defer CloseAndLogErrorWithLogger(file, "Unable to close file.", p.Logger)
+
+	indexes := p.columnIndexes(parquetFile)
+
+	for rowGroup := parquetFile.RowGroups() - 1; rowGroup >= 0; rowGroup-- {
+		rows, err := p.readRowGroup(parquetFile, rowGroup, indexes)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(rows) > 0 {
+			return rows[len(rows)-1], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// AppendToFile appends the provided rows of data to the end of the specified file. As the Parquet
+// files cannot be appended in place, the existing rows and the provided rows are written to a new
+// file, which then replaces the existing one.
+func (p *Parquet[T]) AppendToFile(fileName string, rows <-chan *T) error {
+	existing, errs, err := p.ReadFromFileWithError(fileName)
+	if err != nil {
+		Drain(rows)
+		return err
+	}
+
+	existingSlice := ChanToSlice(existing)
+
+	err = <-errs
+	if err != nil {
+		Drain(rows)
+		return err
+	}
+
+	combined := make(chan *T)
+
+	go func() {
+		defer close(combined)
+
+		for _, row := range existingSlice {
+			combined <- row
+		}
+
+		for row := range rows {
+			combined <- row
+		}
+	}()
+
+	return p.WriteToFile(fileName, combined)
+}
+
+// WriteToFile creates a new file with the given name and writes the provided rows
+// of data to it, overwriting any existing content. The file is replaced only once
+// all rows are written.
+func (p *Parquet[T]) WriteToFile(fileName string, rows <-chan *T) error {
+	// Drain the remaining rows if the writing fails early.
+	defer Drain(rows)
+
+	fileName = filepath.Clean(fileName)
+
+	file, err := os.CreateTemp(filepath.Dir(fileName), filepath.Base(fileName)+".*")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(file.Name())
+
+	err = p.writeToWriter(file, rows)
+	if err != nil {
+		CloseAndLogErrorWithLogger(file, "Unable to close file.", p.Logger)
+		return err
+	}
+
+	err = file.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), fileName)
+}
+
+// readFromFile opens the Parquet file with the given name and delivers its rows through the rows
+// channel. If the given since column is not -1, the rows before the since date are skipped.
+func (p *Parquet[T]) readFromFile(fileName string, sinceColumn int, since time.Time) (<-chan *T, <-chan error, error) {
+	file, parquetFile, err := p.open(fileName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make(chan *T)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(rows)
+		defer CloseAndLogErrorWithLogger(file, "Unable to close file.", p.Logger)
+
+		err := p.read(parquetFile, rows, sinceColumn, since)
+		if err != nil {
+			errs <- err
+		}
+	}()
+
+	return rows, errs, nil
+}
+
+// open opens the Parquet file with the given name and reads its metadata.
+func (p *Parquet[T]) open(fileName string) (*os.File, *parquet.File, error) {
+	file, err := os.Open(filepath.Clean(fileName))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stat, err := file.Stat()
+	if err == nil {
+		var parquetFile *parquet.File
+
+		parquetFile, err = parquet.Open(file, stat.Size())
+		if err == nil {
+			return file, parquetFile, nil
+		}
+	}
+
+	CloseAndLogErrorWithLogger(file, "Unable to close file.", p.Logger)
+
+	return nil, nil, err
+}
+
+// read reads the rows of the given Parquet file and sends them to the given channel. If the given
+// since column is not -1, the row groups and the rows before the since date are skipped. It returns
+// the error that ended the reading early, if any.
+func (p *Parquet[T]) read(parquetFile *parquet.File, rows chan<- *T, sinceColumn int, since time.Time) error {
+	indexes := p.columnIndexes(parquetFile)
+
+	for rowGroup := 0; rowGroup < parquetFile.RowGroups(); rowGroup++ {
+		if sinceColumn != -1 && indexes[sinceColumn] != -1 {
+			column := indexes[sinceColumn]
+
+			last, ok := parquetFile.ColumnMax(rowGroup, column)
+			if ok {
+				date, err := parquet.Time(last, parquetFile.Columns()[column].TimeUnit)
+				if err == nil && date.Before(since) {
+					continue
+				}
+			}
+		}
+
+		groupRows, err := p.readRowGroup(parquetFile, rowGroup, indexes)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range groupRows {
+			if sinceColumn != -1 {
+				date := reflect.ValueOf(row).Elem().Field(p.columns[sinceColumn].FieldIndex).Interface().(time.Time)
+				if date.Before(since) {
+					continue
+				}
+			}
+
+			rows <- row
+		}
+	}
+
+	return nil
+}
+
+// readRowGroup reads the rows of the given row group using the given column indexes.
+func (p *Parquet[T]) readRowGroup(parquetFile *parquet.File, rowGroup int, indexes []int) ([]*T, error) {
+	rows := make([]*T, parquetFile.Rows(rowGroup))
+	for i := range rows {
+		rows[i] = new(T)
+	}
+
+	for i, column := range p.columns {
+		if indexes[i] == -1 {
+			continue
+		}
+
+		values, err := parquetFile.ReadColumn(rowGroup, indexes[i])
+		if err != nil {
+			return nil, err
+		}
+
+		if len(values) != len(rows) {
+			return nil, fmt.Errorf("column %s has %d values instead of %d", column.Name, len(values), len(rows))
+		}
+
+		for j, value := range values {
+			field := reflect.ValueOf(rows[j]).Elem().Field(column.FieldIndex)
+
+			err := setParquetValue(field, value, parquetFile.Columns()[indexes[i]].TimeUnit)
+			if err != nil {
+				return nil, fmt.Errorf("unable to set value of %s: %w", column.Name, err)
+			}
+		}
+	}
+
+	return rows, nil
+}
+
+// columnIndexes returns the indexes of the file columns for the struct fields, or -1 for
+// the fields without a matching column.
+func (p *Parquet[T]) columnIndexes(parquetFile *parquet.File) []int {
+	indexes := make([]int, len(p.columns))
+
+	for i, column := range p.columns {
+		indexes[i] = -1
+
+		for j, fileColumn := range parquetFile.Columns() {
+			if fileColumn.Name == column.Name {
+				indexes[i] = j
+				break
+			}
+
+			if indexes[i] == -1 && strings.EqualFold(fileColumn.Name, column.Name) {
+				indexes[i] = j
+			}
+		}
+	}
+
+	return indexes
+}
+
+// fieldType returns the type of the struct field for the given column.
+func (p *Parquet[T]) fieldType(column int) reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem().Field(p.columns[column].FieldIndex).Type
+}
+
+// writeToWriter writes the provided rows of data to the specified writer as row groups.
+func (p *Parquet[T]) writeToWriter(writer io.Writer, rows <-chan *T) error {
+	columns := make([]parquet.Column, len(p.columns))
+
+	for i, column := range p.columns {
+		columns[i] = parquet.Column{
+			Name: column.Name,
+			Type: column.Type,
+		}
+
+		if p.fieldType(i) == reflect.TypeOf(time.Time{}) {
+			columns[i].TimeUnit = time.Millisecond
+		}
+	}
+
+	parquetWriter, err := parquet.NewWriter(writer, columns)
+	if err != nil {
+		return err
+	}
+
+	batch := make([]*T, 0, p.RowGroupSize)
+
+	for {
+		row, ok := <-rows
+		if ok {
+			batch = append(batch, row)
+		}
+
+		if len(batch) > 0 && (!ok || len(batch) == p.RowGroupSize) {
+			values := make([][]any, len(p.columns))
+
+			for i, column := range p.columns {
+				values[i] = make([]any, len(batch))
+
+				for j, row := range batch {
+					values[i][j] = parquetValueOf(reflect.ValueOf(row).Elem().Field(column.FieldIndex))
+				}
+			}
+
+			err = parquetWriter.WriteRowGroup(values)
+			if err != nil {
+				return err
+			}
+
+			batch = batch[:0]
+		}
+
+		if !ok {
+			break
+		}
+	}
+
+	return parquetWriter.Close()
+}
+
+// parquetTypeOf returns the physical type the given field type is written as.
+func parquetTypeOf(fieldType reflect.Type) (parquet.Type, error) {
+	if fieldType == reflect.TypeOf(time.Time{}) {
+		return parquet.Int64, nil
+	}
+
+	switch fieldType.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return parquet.Double, nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return parquet.Int64, nil
+
+	case reflect.String:
+		return parquet.ByteArray, nil
+
+	default:
+		return 0, fmt.Errorf("unsupported parquet field type %s", fieldType)
+	}
+}
+
+// parquetValueOf returns the value of the given field as it is written.
+func parquetValueOf(field reflect.Value) any {
+	if date, ok := field.Interface().(time.Time); ok {
+		return date.UnixMilli()
+	}
+
+	switch field.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return field.Float()
+
+	case reflect.String:
+		return field.String()
+
+	default:
+		return field.Int()
+	}
+}
+
+// setParquetValue sets the given field to the given value read from a column with the given
+// time unit. The null values are left as zero.
+func setParquetValue(field reflect.Value, value any, unit time.Duration) error {
+	if value == nil {
+		return nil
+	}
+
+	if field.Type() == reflect.TypeOf(time.Time{}) {
+		date, err := parquet.Time(value, unit)
+		if err != nil {
+			return err
+		}
+
+		field.Set(reflect.ValueOf(date))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.Float32, reflect.Float64:
+		switch value := value.(type) {
+		case int32:
+			field.SetFloat(float64(value))
+		case int64:
+			field.SetFloat(float64(value))
+		case float32:
+			field.SetFloat(float64(value))
+		case float64:
+			field.SetFloat(value)
+		default:
+			return fmt.Errorf("unable to convert %T to float", value)
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch value := value.(type) {
+		case int32:
+			field.SetInt(int64(value))
+		case int64:
+			field.SetInt(value)
+		default:
+			return fmt.Errorf("unable to convert %T to int", value)
+		}
+
+	case reflect.String:
+		bytes, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unable to convert %T to string", value)
+		}
+
+		field.SetString(string(bytes))
+
+	default:
+		return fmt.Errorf("unsupported parquet field type %s", field.Type())
+	}
+
+	return nil
+}
+
+// ReadFromParquetFile creates a Parquet instance, parses Parquet data from the provided filename,
+// maps the data to corresponding struct fields, and delivers it through the channel.
+func ReadFromParquetFile[T any](fileName string) (<-chan *T, error) {
+	parquet, err := NewParquet[T]()
+	if err != nil {
+		return nil, err
+	}
+
+	return parquet.ReadFromFile(fileName)
+}
+
+// ReadFromParquetFileWithError creates a Parquet instance, parses Parquet data from the provided
+// filename, maps the data to corresponding struct fields, and delivers it through the rows
+// channel. Any error that ends the reading early is delivered through the errors channel,
+// which is closed after the rows channel.
+func ReadFromParquetFileWithError[T any](fileName string) (<-chan *T, <-chan error, error) {
+	parquet, err := NewParquet[T]()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return parquet.ReadFromFileWithError(fileName)
+}
+
+// AppendOrWriteToParquetFile writes the provided rows of data to the specified file, appending
+// to the existing file if it exists or creating a new one if it doesn't.
+func AppendOrWriteToParquetFile[T any](fileName string, rows <-chan *T) error {
+	parquet, err := NewParquet[T]()
+	if err != nil {
+		Drain(rows)
+		return err
+	}
+
+	stat, err := os.Stat(filepath.Clean(fileName))
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			Drain(rows)
+			return err
+		}
+	} else if stat.Size() > 0 {
+		return parquet.AppendToFile(fileName, rows)
+	}
+
+	return parquet.WriteToFile(fileName, rows)
+}
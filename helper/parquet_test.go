@@ -0,0 +1,381 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/miromax42/indicator/v2/helper"
+)
+
+type parquetRow struct {
+	Date   time.Time
+	Name   string `parquet:"name"`
+	Close  float64
+	Volume int64
+}
+
+func parquetRows(count int) []*parquetRow {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	rows := make([]*parquetRow, count)
+
+	for i := range rows {
+		rows[i] = &parquetRow{
+			Date:   start.AddDate(0, 0, i),
+			Name:   "SP500",
+			Close:  float64(i) + 0.5,
+			Volume: int64(i * 100),
+		}
+	}
+
+	return rows
+}
+
+func TestParquet(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "test.parquet")
+	expected := parquetRows(25)
+
+	parquet, err := helper.NewParquet[parquetRow]()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parquet.RowGroupSize = 10
+
+	err = parquet.WriteToFile(fileName, helper.SliceToChan(expected))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, errs, err := parquet.ReadFromFileWithError(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = helper.CheckEquals(rows, helper.SliceToChan(expected))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = <-errs
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParquetReadFromFileSince(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "test.parquet")
+	expected := parquetRows(25)
+
+	parquet, err := helper.NewParquet[parquetRow]()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parquet.RowGroupSize = 10
+
+	err = parquet.WriteToFile(fileName, helper.SliceToChan(expected))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, errs, err := parquet.ReadFromFileSince(fileName, "Date", expected[13].Date)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = helper.CheckEquals(rows, helper.SliceToChan(expected[13:]))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = <-errs
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParquetReadFromFileSinceSkipsRowGroups(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "test.parquet")
+	input := parquetRows(3)
+
+	parquet, err := helper.NewParquet[parquetRow]()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parquet.RowGroupSize = 2
+
+	err = parquet.WriteToFile(fileName, helper.SliceToChan(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Corrupt the first page header of the first row group, which should not be read.
+	copy(data[4:], []byte{0xff, 0xff, 0xff, 0xff})
+
+	err = os.WriteFile(fileName, data, 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, errs, err := parquet.ReadFromFileSince(fileName, "Date", input[2].Date)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = helper.CheckEquals(rows, helper.SliceToChan(input[2:]))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = <-errs
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParquetReadFromFileSinceMissingColumn(t *testing.T) {
+	parquet, err := helper.NewParquet[parquetRow]()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err = parquet.ReadFromFileSince("testdata/missing.parquet", "Close", time.Now())
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+// fixtureRows are the rows of the synthetic Parquet files in the internal/parquet/testdata
+// directory, with the Snappy compressed dictionary pages and the Zstd compressed version 2
+// data pages, where the null closing is read as zero.
+func fixtureRows() []*parquetRow {
+	start := time.Date(2024, 1, 2, 14, 30, 0, 0, time.UTC)
+	names := []string{"SP500", "SP500", "NDX", "NDX", "SP500", "SP500", "NDX", "NDX"}
+	closings := []float64{4742.83, 4745.2, 16543.94, 0, 4741.1, 4745.2, 16550.12, 16550.12}
+	volumes := []int64{1200, 980, 1500, 1100, 870, 990, 1300, 1250}
+
+	rows := make([]*parquetRow, len(names))
+
+	for i := range rows {
+		rows[i] = &parquetRow{
+			Date:   start.Add(time.Duration(i) * 5 * time.Minute),
+			Name:   names[i],
+			Close:  closings[i],
+			Volume: volumes[i],
+		}
+	}
+
+	return rows
+}
+
+func TestParquetReadFixtures(t *testing.T) {
+	files := []string{
+		"../internal/parquet/testdata/synthetic_snappy_dictionary.parquet",
+		"../internal/parquet/testdata/synthetic_zstd_data_page_v2.parquet",
+	}
+
+	for _, fileName := range files {
+		rows, errs, err := helper.ReadFromParquetFileWithError[parquetRow](fileName)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = helper.CheckEquals(rows, helper.SliceToChan(fixtureRows()))
+		if err != nil {
+			t.Fatalf("%s: %v", fileName, err)
+		}
+
+		err = <-errs
+		if err != nil {
+			t.Fatalf("%s: %v", fileName, err)
+		}
+	}
+}
+
+func TestParquetReadFixtureSince(t *testing.T) {
+	parquet, err := helper.NewParquet[parquetRow]()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := fixtureRows()
+
+	rows, errs, err := parquet.ReadFromFileSince("../internal/parquet/testdata/synthetic_snappy_dictionary.parquet", "Date", expected[5].Date)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = helper.CheckEquals(rows, helper.SliceToChan(expected[5:]))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = <-errs
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParquetReadLastFromFile(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "test.parquet")
+	expected := parquetRows(25)
+
+	parquet, err := helper.NewParquet[parquetRow]()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parquet.RowGroupSize = 10
+
+	err = parquet.WriteToFile(fileName, helper.SliceToChan(expected))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	last, err := parquet.ReadLastFromFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *last != *expected[len(expected)-1] {
+		t.Fatalf("actual %v expected %v", last, expected[len(expected)-1])
+	}
+}
+
+func TestParquetReadLastFromEmptyFile(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "test.parquet")
+
+	parquet, err := helper.NewParquet[parquetRow]()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = parquet.WriteToFile(fileName, helper.SliceToChan([]*parquetRow{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	last, err := parquet.ReadLastFromFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if last != nil {
+		t.Fatalf("actual %v expected nil", last)
+	}
+}
+
+func TestParquetMatchingColumns(t *testing.T) {
+	type Row struct {
+		Date  time.Time `parquet:"date"`
+		Name  string
+		Open  float64
+		Close float64
+	}
+
+	fileName := filepath.Join(t.TempDir(), "test.parquet")
+	input := parquetRows(3)
+
+	err := helper.AppendOrWriteToParquetFile(fileName, helper.SliceToChan(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := helper.ReadFromParquetFile[Row](fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, row := range helper.ChanToSlice(rows) {
+		expected := Row{
+			Date:  input[i].Date,
+			Name:  input[i].Name,
+			Close: input[i].Close,
+		}
+
+		if *row != expected {
+			t.Fatalf("actual %v expected %v", row, expected)
+		}
+	}
+}
+
+func TestAppendOrWriteToParquetFile(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "test.parquet")
+	expected := parquetRows(15)
+
+	err := helper.AppendOrWriteToParquetFile(fileName, helper.SliceToChan(expected[:10]))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = helper.AppendOrWriteToParquetFile(fileName, helper.SliceToChan(expected[10:]))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, errs, err := helper.ReadFromParquetFileWithError[parquetRow](fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = helper.CheckEquals(rows, helper.SliceToChan(expected))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = <-errs
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParquetReadFromInvalidFile(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "test.parquet")
+
+	err := os.WriteFile(fileName, []byte("Date,Close\n"), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = helper.ReadFromParquetFile[parquetRow](fileName)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestParquetReadFromMissingFile(t *testing.T) {
+	_, _, err := helper.ReadFromParquetFileWithError[parquetRow]("testdata/missing.parquet")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestParquetNotStruct(t *testing.T) {
+	_, err := helper.NewParquet[int]()
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestParquetUnsupportedField(t *testing.T) {
+	type Row struct {
+		Flags []bool
+	}
+
+	_, err := helper.NewParquet[Row]()
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
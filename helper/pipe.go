@@ -4,6 +4,8 @@
 
 package helper
 
+import "context"
+
 // Pipe function takes an input channel and an output channel and copies
 // all elements from the input channel into the output channel.
 //
@@ -19,3 +21,29 @@ func Pipe[T any](f <-chan T, t chan<- T) {
 		t <- n
 	}
 }
+
+// PipeWithContext function takes an input channel and an output channel and copies
+// all elements from the input channel into the output channel until the context is
+// done. The output channel is closed afterwards. The input channel is not drained, as
+// it may never close, so its producer must observe the same context to terminate.
+//
+// Example:
+//
+//	input := helper.SliceToChanWithContext(ctx, []int{2, 4, 6, 8})
+//	output := make(chan int)
+//	go helper.PipeWithContext(ctx, input, output)
+//	fmt.println(helper.ChanToSlice(output)) // [2, 4, 6, 8]
+func PipeWithContext[T any](ctx context.Context, f <-chan T, t chan<- T) {
+	defer close(t)
+
+	for {
+		n, ok := receiveWithContext(ctx, f)
+		if !ok {
+			break
+		}
+
+		if !sendWithContext(ctx, t, n) {
+			break
+		}
+	}
+}
@@ -5,6 +5,8 @@
 package helper_test
 
 import (
+	"context"
+	"runtime"
 	"testing"
 
 	"github.com/miromax42/indicator/v2/helper"
@@ -24,3 +26,22 @@ func TestPipe(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestPipeWithContextCancel(t *testing.T) {
+	goroutines := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	input := liveFeed(ctx)
+	actual := make(chan int)
+
+	go helper.PipeWithContext(ctx, input, actual)
+
+	<-actual
+	cancel()
+
+	err := helper.CheckNoGoroutineLeak(goroutines)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"math/bits"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -67,16 +68,41 @@ func setReflectValueFromFloat(value reflect.Value, stringValue string, bitSize i
 	return err
 }
 
-// setReflectValueFromTime assigns the parsed unsigned float value to the specified variable.
+// setReflectValueFromTime assigns the parsed time value to the specified variable. The value
+// is parsed using the first matching format among the alternative formats.
 func setReflectValueFromTime(value reflect.Value, stringValue, format string) error {
-	actualValue, err := time.Parse(format, stringValue)
-	if err == nil {
-		value.Set(reflect.ValueOf(actualValue))
+	var err error
+
+	for _, layout := range strings.Split(format, CsvFormatSeparator) {
+		var actualValue time.Time
+
+		actualValue, err = time.Parse(layout, stringValue)
+		if err == nil {
+			value.Set(reflect.ValueOf(actualValue))
+			return nil
+		}
 	}
 
 	return err
 }
 
+// getTimeString returns the string representation of the given time using the first format
+// among the alternative formats that preserves it, or the last format if none does.
+func getTimeString(value time.Time, format string) string {
+	layouts := strings.Split(format, CsvFormatSeparator)
+
+	for _, layout := range layouts[:len(layouts)-1] {
+		stringValue := value.Format(layout)
+
+		parsedValue, err := time.Parse(layout, stringValue)
+		if err == nil && parsedValue.Equal(value) {
+			return stringValue
+		}
+	}
+
+	return value.Format(layouts[len(layouts)-1])
+}
+
 // setReflectValue assigns the parsed value to the specified variable.
 func setReflectValue(value reflect.Value, stringValue, format string) error {
 	kind := value.Kind()
@@ -139,7 +165,7 @@ func getReflectValue(value reflect.Value, format string) (string, error) {
 
 		switch typeString {
 		case "time.Time":
-			return value.Interface().(time.Time).Format(format), nil
+			return getTimeString(value.Interface().(time.Time), format), nil
 
 		default:
 			return "", fmt.Errorf("unsupported struct type %s", typeString)
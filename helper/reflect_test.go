@@ -642,3 +642,63 @@ func TestGetReflectValueFromUnexpectedKind(t *testing.T) {
 		t.Fatalf("actual %v expected error", actual)
 	}
 }
+
+func TestSetReflectValueFromTimeWithAlternativeFormats(t *testing.T) {
+	format := "2006-01-02|2006-01-02T15:04:05Z07:00"
+
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{"2023-11-28", time.Date(2023, 11, 28, 0, 0, 0, 0, time.UTC)},
+		{"2023-11-28T19:14:00Z", time.Date(2023, 11, 28, 19, 14, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		var actual time.Time
+		value := reflect.ValueOf(&actual).Elem()
+
+		err := setReflectValue(value, test.input, format)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !actual.Equal(test.expected) {
+			t.Fatalf("actual %v expected %v", actual, test.expected)
+		}
+	}
+
+	var actual time.Time
+	value := reflect.ValueOf(&actual).Elem()
+
+	err := setReflectValue(value, "abcd", format)
+	if err == nil {
+		t.Fatalf("actual %v expected error", actual)
+	}
+}
+
+func TestGetReflectValueFromTimeWithAlternativeFormats(t *testing.T) {
+	format := "2006-01-02|2006-01-02T15:04:05Z07:00"
+
+	tests := []struct {
+		input    time.Time
+		expected string
+	}{
+		{time.Date(2023, 11, 28, 0, 0, 0, 0, time.UTC), "2023-11-28"},
+		{time.Date(2023, 11, 28, 19, 14, 0, 0, time.UTC), "2023-11-28T19:14:00Z"},
+		{time.Date(2023, 11, 28, 0, 0, 0, 0, time.FixedZone("EST", -5*60*60)), "2023-11-28T00:00:00-05:00"},
+	}
+
+	for _, test := range tests {
+		value := reflect.ValueOf(&test.input).Elem()
+
+		actual, err := getReflectValue(value, format)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if actual != test.expected {
+			t.Fatalf("actual %v expected %v", actual, test.expected)
+		}
+	}
+}
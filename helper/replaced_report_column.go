@@ -0,0 +1,18 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+// replacedReportColumn is the report column struct that replaces another column.
+type replacedReportColumn struct {
+	ReportColumn
+	replaced ReportColumn
+}
+
+// Value returns the next data value for the report column, after reading and
+// discarding the next data value of the replaced column.
+func (c *replacedReportColumn) Value() string {
+	c.replaced.Value()
+	return c.ReportColumn.Value()
+}
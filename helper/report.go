@@ -10,6 +10,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"text/template"
 	"time"
 )
@@ -18,8 +19,14 @@ import (
 var reportTmpl string
 
 const (
+	// ReportDateFormat is the date only format used in the report for the daily and longer bars.
+	ReportDateFormat = "2006-01-02"
+
+	// ReportDateTimeFormat is the date and time format that keeps the times of the intraday bars.
+	ReportDateTimeFormat = "2006-01-02T15:04:05Z07:00"
+
 	// DefaultReportDateFormat is the default date format used in the report.
-	DefaultReportDateFormat = "2006-01-02"
+	DefaultReportDateFormat = ReportDateFormat
 )
 
 // ReportColumn defines the interface that all report data columns must implement.
@@ -93,6 +100,58 @@ func (r *Report) AddColumn(column ReportColumn, charts ...int) {
 	}
 }
 
+// ReplaceColumn replaces the data column with the given name by the given column, and
+// returns the charts that it is in. The values of the replaced column are still read,
+// and discarded, as they may share a common source with the other columns. It returns
+// no charts when there is no data column with the given name.
+func (r *Report) ReplaceColumn(name string, column ReportColumn) []int {
+	for i, current := range r.Columns {
+		if current.Role() != "data" || current.Name() != name {
+			continue
+		}
+
+		r.Columns[i] = &replacedReportColumn{
+			ReportColumn: column,
+			replaced:     current,
+		}
+
+		charts := []int{}
+
+		for chartID, view := range r.Views {
+			if slices.Contains(view, i+1) {
+				charts = append(charts, chartID)
+			}
+		}
+
+		return charts
+	}
+
+	return nil
+}
+
+// StepSeries returns the series indexes of the columns drawn as step lines on the specified
+// chart. The series indexes only count the data columns of the chart.
+func (r *Report) StepSeries(chartID int) []int {
+	series := []int{}
+	index := 0
+
+	for _, columnID := range r.Views[chartID] {
+		column := r.Columns[columnID-1]
+
+		if column.Role() != "data" {
+			continue
+		}
+
+		if _, ok := column.(steppedReportColumn); ok {
+			series = append(series, index)
+		}
+
+		index++
+	}
+
+	return series
+}
+
 // WriteToWriter writes the report content to the provided io.Writer.
 // This allows the report to be sent to various destinations, such
 // as a file, a network socket, or even the standard output.
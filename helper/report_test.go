@@ -76,3 +76,58 @@ func TestReportWriteToFileFailed(t *testing.T) {
 		t.Fatal("expected error")
 	}
 }
+
+func TestReportStepSeries(t *testing.T) {
+	values := helper.SliceToChan([]float64{})
+
+	report := helper.NewReport("Test Report", helper.SliceToChan([]time.Time{}))
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", values))
+	report.AddColumn(helper.NewAnnotationReportColumn(helper.SliceToChan([]string{})))
+	report.AddColumn(helper.NewStepReportColumn("Level", values), 0, 1)
+
+	actual := report.StepSeries(0)
+	if len(actual) != 1 || actual[0] != 1 {
+		t.Fatalf("actual %v expected [1]", actual)
+	}
+
+	actual = report.StepSeries(1)
+	if len(actual) != 1 || actual[0] != 0 {
+		t.Fatalf("actual %v expected [0]", actual)
+	}
+}
+
+func TestReportReplaceColumn(t *testing.T) {
+	replaced := helper.SliceToChan([]float64{1, 2})
+
+	report := helper.NewReport("Test Report", helper.SliceToChan([]time.Time{}))
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", helper.SliceToChan([]float64{})))
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", replaced), 1)
+
+	charts := report.ReplaceColumn("Outcome", helper.NewNumericReportColumn("Outcome", helper.SliceToChan([]float64{3, 4})))
+	if len(charts) != 1 || charts[0] != 1 {
+		t.Fatalf("actual %v expected [1]", charts)
+	}
+
+	column := report.Columns[1]
+
+	for _, expected := range []string{"3", "4"} {
+		actual := column.Value()
+		if actual != expected {
+			t.Fatalf("actual %v expected %v", actual, expected)
+		}
+	}
+
+	_, ok := <-replaced
+	if ok {
+		t.Fatal("expected replaced column to be read")
+	}
+
+	charts = report.ReplaceColumn("Missing", helper.NewNumericReportColumn("Missing", helper.SliceToChan([]float64{})))
+	if len(charts) != 0 {
+		t.Fatalf("actual %v expected []", charts)
+	}
+}
@@ -4,6 +4,8 @@
 
 package helper
 
+import "context"
+
 // Skip skips the specified number of elements from the
 // given channel of float64.
 //
@@ -28,3 +30,28 @@ func Skip[T any](c <-chan T, count int) <-chan T {
 
 	return result
 }
+
+// SkipWithContext skips the specified number of elements from the given channel
+// and delivers the remaining elements until the context is done.
+//
+// Example:
+//
+//	c := helper.SliceToChan([]int{2, 4, 6, 8})
+//	actual := helper.SkipWithContext(ctx, c, 2)
+//	fmt.Println(helper.ChanToSlice(actual)) // [6, 8]
+func SkipWithContext[T any](ctx context.Context, c <-chan T, count int) <-chan T {
+	result := make(chan T, cap(c))
+
+	go func() {
+		for i := 0; i < count; i++ {
+			_, ok := receiveWithContext(ctx, c)
+			if !ok {
+				break
+			}
+		}
+
+		PipeWithContext(ctx, c, result)
+	}()
+
+	return result
+}
@@ -5,6 +5,8 @@
 package helper_test
 
 import (
+	"context"
+	"runtime"
 	"testing"
 
 	"github.com/miromax42/indicator/v2/helper"
@@ -21,3 +23,31 @@ func TestSkip(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestSkipWithContext(t *testing.T) {
+	input := helper.SliceToChan([]int{2, 4, 6, 8})
+	expected := helper.SliceToChan([]int{6, 8})
+
+	actual := helper.SkipWithContext(context.Background(), input, 2)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSkipWithContextCancel(t *testing.T) {
+	goroutines := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	actual := helper.SkipWithContext(ctx, liveFeed(ctx), 2)
+
+	<-actual
+	cancel()
+
+	err := helper.CheckNoGoroutineLeak(goroutines)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
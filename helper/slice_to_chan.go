@@ -4,6 +4,8 @@
 
 package helper
 
+import "context"
+
 // SliceToChan converts a slice of float64 to a channel of float64.
 //
 // Example:
@@ -27,3 +29,28 @@ func SliceToChan[T any](slice []T) <-chan T {
 
 	return c
 }
+
+// SliceToChanWithContext converts a slice to a channel, stopping early
+// once the context is done.
+//
+// Example:
+//
+//	slice := []float64{2, 4, 6, 8}
+//	c := helper.SliceToChanWithContext(ctx, slice)
+//	fmt.Println(<- c)  // 2
+//	fmt.Println(<- c)  // 4
+func SliceToChanWithContext[T any](ctx context.Context, slice []T) <-chan T {
+	c := make(chan T)
+
+	go func() {
+		defer close(c)
+
+		for _, n := range slice {
+			if !sendWithContext(ctx, c, n) {
+				break
+			}
+		}
+	}()
+
+	return c
+}
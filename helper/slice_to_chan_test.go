@@ -5,7 +5,9 @@
 package helper_test
 
 import (
+	"context"
 	"reflect"
+	"runtime"
 	"testing"
 
 	"github.com/miromax42/indicator/v2/helper"
@@ -19,3 +21,19 @@ func TestSliceToChan(t *testing.T) {
 		t.Fatalf("actual %v expected %v", actual, expected)
 	}
 }
+
+func TestSliceToChanWithContextCancel(t *testing.T) {
+	goroutines := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	c := helper.SliceToChanWithContext(ctx, make([]int, 1000))
+
+	<-c
+	cancel()
+
+	err := helper.CheckNoGoroutineLeak(goroutines)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
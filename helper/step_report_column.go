@@ -0,0 +1,33 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+// steppedReportColumn is the interface for the report columns drawn as step lines.
+type steppedReportColumn interface {
+	ReportColumn
+
+	// isStepped marks the column as drawn as step lines.
+	isStepped()
+}
+
+// stepReportColumn is the step report column struct.
+type stepReportColumn[T Number] struct {
+	*numericReportColumn[T]
+}
+
+// NewStepReportColumn returns a new instance of a numeric data column for a report that is
+// drawn as horizontal step lines instead of a curve. It suits the levels that stay constant
+// for a period, such as the support and resistance levels.
+func NewStepReportColumn[T Number](name string, values <-chan T) ReportColumn {
+	return &stepReportColumn[T]{
+		numericReportColumn: &numericReportColumn[T]{
+			name:   name,
+			values: values,
+		},
+	}
+}
+
+// isStepped marks the column as drawn as step lines.
+func (*stepReportColumn[T]) isStepped() {}
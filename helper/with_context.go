@@ -0,0 +1,99 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import "context"
+
+// WithContext returns a new channel that delivers the values from the given
+// channel until the context is done. Once the context is done, the returned
+// channel is closed. The given channel is not drained, as a live feed may
+// never close, so its producer must observe the same context to terminate.
+// Wrapping the input of a pipeline with WithContext and its output with
+// OutputWithContext tears down the whole pipeline when the context is done.
+//
+// Example:
+//
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//
+//	input := helper.WithContext(ctx, helper.SliceToChanWithContext(ctx, []int{2, 4, 6, 8}))
+//	output := helper.OutputWithContext(ctx, helper.MultiplyBy(input, 2))
+//
+//	fmt.Println(<-output) // 4
+//	cancel()
+func WithContext[T any](ctx context.Context, c <-chan T) <-chan T {
+	result := make(chan T, cap(c))
+
+	go PipeWithContext(ctx, c, result)
+
+	return result
+}
+
+// OutputWithContext returns a new channel that delivers the values from the given
+// output channel of a pipeline until the context is done. Once the context is done,
+// the returned channel is closed and the remaining values of the given channel are
+// drained, allowing the goroutines of the pipeline to terminate. As the draining
+// waits for the given channel to close, the inputs of the pipeline must end once
+// the context is done, such as by wrapping them with WithContext.
+//
+// Example:
+//
+//	closings := helper.WithContext(ctx, feed)
+//	macds, signals := trend.NewMacd[float64]().Compute(closings)
+//
+//	macds = helper.OutputWithContext(ctx, macds)
+//	signals = helper.OutputWithContext(ctx, signals)
+func OutputWithContext[T any](ctx context.Context, c <-chan T) <-chan T {
+	result := make(chan T, cap(c))
+
+	go func() {
+		PipeWithContext(ctx, c, result)
+		Drain(c)
+	}()
+
+	return result
+}
+
+// receiveWithContext receives the next value from the given channel unless
+// the context is done first. It returns false if the channel is closed or
+// the context is done.
+func receiveWithContext[T any](ctx context.Context, c <-chan T) (T, bool) {
+	var zero T
+
+	// Give the context priority over the pending values.
+	if ctx.Err() != nil {
+		return zero, false
+	}
+
+	select {
+	case n, ok := <-c:
+		return n, ok
+
+	case <-ctx.Done():
+		return zero, false
+	}
+}
+
+// drainWithContext drains the given channel until it is closed or the context is done.
+func drainWithContext[T any](ctx context.Context, c <-chan T) {
+	for {
+		_, ok := receiveWithContext(ctx, c)
+		if !ok {
+			return
+		}
+	}
+}
+
+// sendWithContext sends the given value to the channel unless the context
+// is done first. It returns false if the context is done.
+func sendWithContext[T any](ctx context.Context, c chan<- T, n T) bool {
+	select {
+	case c <- n:
+		return true
+
+	case <-ctx.Done():
+		return false
+	}
+}
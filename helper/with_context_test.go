@@ -0,0 +1,78 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"context"
+	"runtime"
+	"testing"
+
+	"github.com/miromax42/indicator/v2/helper"
+)
+
+// liveFeed returns a channel that never closes on its own, delivering zeros
+// like a live price feed until the context is done.
+func liveFeed(ctx context.Context) <-chan int {
+	c := make(chan int)
+
+	go func() {
+		defer close(c)
+
+		for {
+			select {
+			case c <- 0:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return c
+}
+
+func TestWithContext(t *testing.T) {
+	input := helper.SliceToChan([]int{2, 4, 6, 8})
+	expected := helper.SliceToChan([]int{2, 4, 6, 8})
+
+	actual := helper.WithContext(context.Background(), input)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWithContextCancel(t *testing.T) {
+	goroutines := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	input := helper.WithContext(ctx, liveFeed(ctx))
+	output := helper.OutputWithContext(ctx, helper.MultiplyBy(helper.IncrementBy(input, 1), 2))
+
+	actual := <-output
+	if actual != 2 {
+		t.Fatalf("actual %v expected 2", actual)
+	}
+
+	cancel()
+
+	err := helper.CheckNoGoroutineLeak(goroutines)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOutputWithContext(t *testing.T) {
+	input := helper.SliceToChan([]int{2, 4, 6, 8})
+	expected := helper.SliceToChan([]int{2, 4, 6, 8})
+
+	actual := helper.OutputWithContext(context.Background(), input)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,142 @@
+// Package parquet contains the Parquet file format reader and writer used by
+// the Parquet helper.
+//
+// This package belongs to the Indicator project. Indicator is
+// a Golang module that supplies a variety of technical
+// indicators, strategies, and a backtesting framework
+// for analysis.
+//
+// # License
+//
+//	Copyright (c) 2021-2024 Onur Cinar.
+//	The source code is provided under GNU AGPLv3 License.
+//	https://github.com/cinar/indicator
+//
+// # Disclaimer
+//
+// The information provided on this project is strictly for
+// informational purposes and is not to be construed as
+// advice or solicitation to buy or sell any security.
+package parquet
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// magic is the magic number at the beginning and the end of the Parquet files.
+const magic = "PAR1"
+
+// Type is the physical type of the column values.
+type Type int32
+
+// Parquet physical types.
+const (
+	// Boolean is the boolean type, read as bool.
+	Boolean Type = 0
+
+	// Int32 is the 32-bit integer type, read as int32.
+	Int32 Type = 1
+
+	// Int64 is the 64-bit integer type, read as int64 and written from int64.
+	Int64 Type = 2
+
+	// Int96 is the legacy timestamp type, read as time.Time.
+	Int96 Type = 3
+
+	// Float is the single precision floating point type, read as float32.
+	Float Type = 4
+
+	// Double is the double precision floating point type, read as float64 and written from float64.
+	Double Type = 5
+
+	// ByteArray is the byte array type, read as []byte and written from string.
+	ByteArray Type = 6
+)
+
+// Parquet repetition types.
+const (
+	repetitionRequired = 0
+	repetitionOptional = 1
+)
+
+// Parquet converted types.
+const (
+	convertedUtf8            = 0
+	convertedDate            = 6
+	convertedTimestampMillis = 9
+	convertedTimestampMicros = 10
+)
+
+// Parquet encodings.
+const (
+	encodingPlain           = 0
+	encodingPlainDictionary = 2
+	encodingRle             = 3
+	encodingRleDictionary   = 8
+)
+
+// Parquet compression codecs.
+const (
+	codecUncompressed = 0
+	codecSnappy       = 1
+	codecGzip         = 2
+	codecZstd         = 6
+)
+
+// Parquet page types.
+const (
+	pageData       = 0
+	pageDictionary = 2
+	pageDataV2     = 3
+)
+
+// julianUnixEpoch is the Julian day of the Unix epoch used by the INT96 timestamps.
+const julianUnixEpoch = 2440588
+
+// errMalformed indicates that the Parquet file is malformed.
+var errMalformed = errors.New("malformed parquet file")
+
+// Column is a flat column in the schema of a Parquet file.
+type Column struct {
+	// Name is the name of the column.
+	Name string
+
+	// Type is the physical type of the column values.
+	Type Type
+
+	// Optional indicates whether the column values can be null.
+	Optional bool
+
+	// TimeUnit is the unit of the timestamp and date columns, and zero for the others. The
+	// columns are written as timestamps in milliseconds when it is a millisecond.
+	TimeUnit time.Duration
+}
+
+// Time converts the given value read from a column with the given time unit to time in UTC.
+func Time(value any, unit time.Duration) (time.Time, error) {
+	switch value := value.(type) {
+	case time.Time:
+		return value, nil
+
+	case int32:
+		if unit == 24*time.Hour {
+			return time.Unix(int64(value)*24*60*60, 0).UTC(), nil
+		}
+
+	case int64:
+		switch unit {
+		case time.Millisecond:
+			return time.UnixMilli(value).UTC(), nil
+
+		case time.Microsecond:
+			return time.UnixMicro(value).UTC(), nil
+
+		case time.Nanosecond:
+			return time.Unix(0, value).UTC(), nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unable to convert %T to time", value)
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package parquet_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/miromax42/indicator/v2/internal/parquet"
+)
+
+func TestTime(t *testing.T) {
+	expected := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value any
+		unit  time.Duration
+	}{
+		{expected, 0},
+		{int32(19724), 24 * time.Hour},
+		{expected.UnixMilli(), time.Millisecond},
+		{expected.UnixMicro(), time.Microsecond},
+		{expected.UnixNano(), time.Nanosecond},
+	}
+
+	for _, test := range tests {
+		actual, err := parquet.Time(test.value, test.unit)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !actual.Equal(expected) || actual.Location() != time.UTC {
+			t.Fatalf("actual %v expected %v", actual, expected)
+		}
+	}
+
+	_, err := parquet.Time(1.5, time.Millisecond)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,620 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+// File is an open Parquet file along with its metadata.
+type File struct {
+	// reader is the reader for the file.
+	reader io.ReaderAt
+
+	// size is the size of the file.
+	size int64
+
+	// columns are the columns in the schema of the file.
+	columns []Column
+
+	// rowGroups are the metadata of the row groups.
+	rowGroups []thriftFields
+}
+
+// Open reads the metadata from the footer of the given Parquet file.
+func Open(reader io.ReaderAt, size int64) (*File, error) {
+	if size < int64(2*len(magic)+4) {
+		return nil, errors.New("not a parquet file")
+	}
+
+	trailer := make([]byte, 4+len(magic))
+
+	_, err := reader.ReadAt(trailer, size-int64(len(trailer)))
+	if err != nil {
+		return nil, err
+	}
+
+	if string(trailer[4:]) != magic {
+		return nil, errors.New("not a parquet file")
+	}
+
+	footerSize := int64(binary.LittleEndian.Uint32(trailer))
+	if footerSize > size-int64(len(trailer)+len(magic)) {
+		return nil, errors.New("malformed parquet footer")
+	}
+
+	footer := make([]byte, footerSize)
+
+	_, err = reader.ReadAt(footer, size-int64(len(trailer))-footerSize)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, _, err := decodeThrift(footer)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode parquet footer: %w", err)
+	}
+
+	file := &File{
+		reader: reader,
+		size:   size,
+	}
+
+	schema := metadata.list(2)
+
+	// The first schema element is the root of the flat columns.
+	for i := 1; i < len(schema); i++ {
+		element, ok := schema[i].(thriftFields)
+		if !ok {
+			return nil, errors.New("malformed parquet schema")
+		}
+
+		if element.i32(5) > 0 {
+			return nil, fmt.Errorf("nested parquet column %s is not supported", element.binary(4))
+		}
+
+		column := Column{
+			Name:     string(element.binary(4)),
+			Type:     Type(element.i32(1)),
+			Optional: element.i32(3) == repetitionOptional,
+			TimeUnit: timeUnitOf(element),
+		}
+
+		if element.i32(3) > repetitionOptional {
+			return nil, fmt.Errorf("repeated parquet column %s is not supported", column.Name)
+		}
+
+		file.columns = append(file.columns, column)
+	}
+
+	for _, rowGroup := range metadata.list(4) {
+		fields, ok := rowGroup.(thriftFields)
+		if !ok || len(fields.list(1)) != len(file.columns) || fields.i64(3) < 0 {
+			return nil, errors.New("malformed parquet row group")
+		}
+
+		file.rowGroups = append(file.rowGroups, fields)
+	}
+
+	return file, nil
+}
+
+// Columns returns the columns in the schema of the file.
+func (f *File) Columns() []Column {
+	return f.columns
+}
+
+// RowGroups returns the number of row groups in the file.
+func (f *File) RowGroups() int {
+	return len(f.rowGroups)
+}
+
+// Rows returns the number of rows in the given row group.
+func (f *File) Rows(rowGroup int) int {
+	return int(f.rowGroups[rowGroup].i64(3))
+}
+
+// timeUnitOf returns the unit of the given timestamp or date schema element, and zero for the others.
+func timeUnitOf(element thriftFields) time.Duration {
+	logicalType := element.fields(10)
+
+	if timestamp := logicalType.fields(8); timestamp != nil {
+		unit := timestamp.fields(2)
+
+		switch {
+		case unit.fields(1) != nil:
+			return time.Millisecond
+
+		case unit.fields(2) != nil:
+			return time.Microsecond
+
+		case unit.fields(3) != nil:
+			return time.Nanosecond
+		}
+	}
+
+	if logicalType.fields(6) != nil {
+		return 24 * time.Hour
+	}
+
+	if _, ok := element[6]; !ok {
+		return 0
+	}
+
+	switch element.i32(6) {
+	case convertedTimestampMillis:
+		return time.Millisecond
+
+	case convertedTimestampMicros:
+		return time.Microsecond
+
+	case convertedDate:
+		return 24 * time.Hour
+
+	default:
+		return 0
+	}
+}
+
+// columnMetadata returns the metadata of the given column in the given row group.
+func (f *File) columnMetadata(rowGroup, column int) thriftFields {
+	chunk, _ := f.rowGroups[rowGroup].list(1)[column].(thriftFields)
+	return chunk.fields(3)
+}
+
+// ColumnMax returns the maximum value of the given column in the given row group based on the
+// column statistics. It returns false if the statistics are not available.
+func (f *File) ColumnMax(rowGroup, column int) (any, bool) {
+	// The byte array statistics are not length prefixed.
+	if f.columns[column].Type == ByteArray {
+		return nil, false
+	}
+
+	statistics := f.columnMetadata(rowGroup, column).fields(12)
+
+	value := statistics.binary(5)
+	if value == nil {
+		value = statistics.binary(1)
+	}
+
+	if value == nil {
+		return nil, false
+	}
+
+	values, err := decodePlain(value, f.columns[column].Type, 1)
+	if err != nil {
+		return nil, false
+	}
+
+	return values[0], true
+}
+
+// ReadColumn reads the values of the given column in the given row group. The null values are nil.
+func (f *File) ReadColumn(rowGroup, column int) ([]any, error) {
+	metadata := f.columnMetadata(rowGroup, column)
+	schema := f.columns[column]
+
+	start := metadata.i64(9)
+	if dictionaryStart := metadata.i64(11); dictionaryStart > 0 && dictionaryStart < start {
+		start = dictionaryStart
+	}
+
+	// The column chunk must be within the file, and have a value for each row, including the nulls.
+	size := metadata.i64(7)
+	if start < 0 || size < 0 || size > f.size-start || metadata.i64(5) != f.rowGroups[rowGroup].i64(3) {
+		return nil, fmt.Errorf("unable to read parquet column %s: %w", schema.Name, errMalformed)
+	}
+
+	data := make([]byte, size)
+
+	_, err := f.reader.ReadAt(data, start)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read parquet column %s: %w", schema.Name, err)
+	}
+
+	codec := metadata.i32(4)
+	count := int(metadata.i64(5))
+	values := make([]any, 0, count)
+
+	var dictionary []any
+
+	for len(values) < count && len(data) > 0 {
+		header, n, err := decodeThrift(data)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode parquet page header: %w", err)
+		}
+
+		size := int(header.i32(3))
+		if size < 0 || size > len(data)-n {
+			return nil, fmt.Errorf("unable to read parquet column %s: %w", schema.Name, errMalformed)
+		}
+
+		page := data[n : n+size]
+		data = data[n+size:]
+
+		// The pages may not have more values than the remaining values of the column.
+		remaining := count - len(values)
+
+		switch header.i32(1) {
+		case pageDictionary:
+			page, err = decompressPage(codec, page, int(header.i32(2)))
+			if err == nil {
+				dictionary, err = decodePlain(page, schema.Type, int(header.fields(7).i32(1)))
+			}
+
+		case pageData:
+			var pageValues []any
+
+			page, err = decompressPage(codec, page, int(header.i32(2)))
+			if err == nil {
+				pageValues, err = decodeDataPage(header.fields(5), page, schema, remaining, dictionary)
+				values = append(values, pageValues...)
+			}
+
+		case pageDataV2:
+			var pageValues []any
+
+			pageValues, err = decodeDataPageV2(header, page, codec, schema, remaining, dictionary)
+			values = append(values, pageValues...)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("unable to read parquet column %s: %w", schema.Name, err)
+		}
+	}
+
+	if len(values) != count {
+		return nil, fmt.Errorf("parquet column %s has %d values instead of %d", schema.Name, len(values), count)
+	}
+
+	return values, nil
+}
+
+// decodeDataPage decodes the values of a version 1 data page with at most the given number of values.
+func decodeDataPage(header thriftFields, page []byte, schema Column, maxCount int, dictionary []any) ([]any, error) {
+	count := int(header.i32(1))
+	if count < 0 || count > maxCount {
+		return nil, errors.New("malformed data page")
+	}
+
+	var levels []int32
+
+	if schema.Optional {
+		if len(page) < 4 {
+			return nil, errors.New("malformed definition levels")
+		}
+
+		size := int64(binary.LittleEndian.Uint32(page))
+		if size > int64(len(page)-4) {
+			return nil, errors.New("malformed definition levels")
+		}
+
+		var err error
+
+		levels, err = decodeHybrid(page[4:4+size], 1, count)
+		if err != nil {
+			return nil, err
+		}
+
+		page = page[4+size:]
+	}
+
+	return decodeValues(page, header.i32(2), schema, count, levels, dictionary)
+}
+
+// decodeDataPageV2 decodes the values of a version 2 data page with at most the given
+// number of values, where the levels are not compressed and not prefixed by their lengths.
+func decodeDataPageV2(header thriftFields, page []byte, codec int32, schema Column, maxCount int, dictionary []any) ([]any, error) {
+	pageHeader := header.fields(8)
+	count := int(pageHeader.i32(1))
+	repetitionSize := int(pageHeader.i32(6))
+	definitionSize := int(pageHeader.i32(5))
+
+	if count < 0 || count > maxCount || repetitionSize < 0 || definitionSize < 0 || repetitionSize > len(page)-definitionSize {
+		return nil, errors.New("malformed data page")
+	}
+
+	var levels []int32
+
+	if schema.Optional {
+		var err error
+
+		levels, err = decodeHybrid(page[repetitionSize:repetitionSize+definitionSize], 1, count)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	page = page[repetitionSize+definitionSize:]
+
+	if pageHeader.boolean(7, true) {
+		var err error
+
+		page, err = decompressPage(codec, page, int(header.i32(2))-repetitionSize-definitionSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return decodeValues(page, pageHeader.i32(4), schema, count, levels, dictionary)
+}
+
+// decodeValues decodes the given number of values, placing nil for the values with the
+// definition level of zero.
+func decodeValues(data []byte, encoding int32, schema Column, count int, levels []int32, dictionary []any) ([]any, error) {
+	defined := count
+	if levels != nil {
+		defined = 0
+
+		for _, level := range levels {
+			if level > 1 {
+				return nil, errors.New("malformed definition levels")
+			}
+
+			defined += int(level)
+		}
+	}
+
+	var values []any
+	var err error
+
+	switch encoding {
+	case encodingPlain:
+		values, err = decodePlain(data, schema.Type, defined)
+
+	case encodingPlainDictionary, encodingRleDictionary:
+		values, err = decodeDictionary(data, defined, dictionary)
+
+	default:
+		err = fmt.Errorf("parquet encoding %d is not supported", encoding)
+	}
+
+	if err != nil || levels == nil {
+		return values, err
+	}
+
+	result := make([]any, count)
+
+	for i, j := 0, 0; i < count; i++ {
+		if levels[i] != 0 {
+			result[i] = values[j]
+			j++
+		}
+	}
+
+	return result, nil
+}
+
+// decodeDictionary decodes the given number of dictionary indexes and returns the
+// dictionary values they refer to.
+func decodeDictionary(data []byte, count int, dictionary []any) ([]any, error) {
+	if count == 0 {
+		return nil, nil
+	}
+
+	if len(data) == 0 {
+		return nil, errors.New("malformed dictionary indexes")
+	}
+
+	indexes, err := decodeHybrid(data[1:], int(data[0]), count)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]any, count)
+
+	for i, index := range indexes {
+		if index < 0 || int(index) >= len(dictionary) {
+			return nil, errors.New("dictionary index out of range")
+		}
+
+		values[i] = dictionary[index]
+	}
+
+	return values, nil
+}
+
+// decodeHybrid decodes the given number of values encoded using the RLE and bit-packing
+// hybrid encoding with the given bit width.
+func decodeHybrid(data []byte, bitWidth, count int) ([]int32, error) {
+	if bitWidth < 0 || bitWidth > 32 || count < 0 {
+		return nil, errors.New("malformed hybrid encoding")
+	}
+
+	values := make([]int32, 0, count)
+	byteWidth := (bitWidth + 7) / 8
+
+	for len(values) < count {
+		header, n := binary.Uvarint(data)
+		if n <= 0 {
+			return nil, errors.New("malformed hybrid encoding")
+		}
+
+		data = data[n:]
+
+		if header&1 == 0 {
+			// The RLE run repeats a single value.
+			if len(data) < byteWidth {
+				return nil, errors.New("malformed hybrid encoding")
+			}
+
+			var value uint32
+			for i := 0; i < byteWidth; i++ {
+				value |= uint32(data[i]) << (8 * i)
+			}
+
+			data = data[byteWidth:]
+
+			for i := uint64(0); i < header>>1 && len(values) < count; i++ {
+				values = append(values, int32(value))
+			}
+
+			continue
+		}
+
+		// The bit-packed run packs groups of eight values.
+		if header>>1 > uint64(len(data)) || int(header>>1)*bitWidth > len(data) {
+			return nil, errors.New("malformed hybrid encoding")
+		}
+
+		size := int(header>>1) * bitWidth
+
+		for i := 0; i < int(header>>1)*8 && len(values) < count; i++ {
+			var value uint32
+
+			for bit := 0; bit < bitWidth; bit++ {
+				position := i*bitWidth + bit
+				value |= uint32(data[position/8]>>(position%8)&1) << bit
+			}
+
+			values = append(values, int32(value))
+		}
+
+		data = data[size:]
+	}
+
+	return values, nil
+}
+
+// decodePlain decodes the given number of values of the given physical type encoded using the plain encoding.
+func decodePlain(data []byte, physicalType Type, count int) ([]any, error) {
+	malformed := errors.New("malformed plain encoding")
+
+	// The minimum sizes of the values, where the byte arrays are prefixed by their lengths,
+	// and the booleans take a single bit.
+	sizes := map[Type]int{
+		Int32:     4,
+		Int64:     8,
+		Int96:     12,
+		Float:     4,
+		Double:    8,
+		ByteArray: 4,
+	}
+
+	maxCount := len(data) * 8
+	if size, ok := sizes[physicalType]; ok {
+		maxCount = len(data) / size
+	}
+
+	if count < 0 || count > maxCount {
+		return nil, malformed
+	}
+
+	values := make([]any, count)
+
+	for i := 0; i < count; i++ {
+		switch physicalType {
+		case Boolean:
+			if i/8 >= len(data) {
+				return nil, malformed
+			}
+
+			values[i] = data[i/8]>>(i%8)&1 == 1
+
+		case Int32:
+			values[i] = int32(binary.LittleEndian.Uint32(data[i*4:]))
+
+		case Int64:
+			values[i] = int64(binary.LittleEndian.Uint64(data[i*8:]))
+
+		case Int96:
+			nanos := int64(binary.LittleEndian.Uint64(data[i*12:]))
+			days := int64(binary.LittleEndian.Uint32(data[i*12+8:]))
+			values[i] = time.Unix((days-julianUnixEpoch)*24*60*60, nanos).UTC()
+
+		case Float:
+			values[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[i*4:]))
+
+		case Double:
+			values[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[i*8:]))
+
+		case ByteArray:
+			if len(data) < 4 {
+				return nil, malformed
+			}
+
+			size := int(binary.LittleEndian.Uint32(data))
+			if 4+size > len(data) {
+				return nil, malformed
+			}
+
+			values[i] = data[4 : 4+size]
+			data = data[4+size:]
+
+		default:
+			return nil, fmt.Errorf("parquet type %d is not supported", physicalType)
+		}
+	}
+
+	return values, nil
+}
+
+// decompressPage decompresses the given page using the given codec, and checks that it
+// has the given uncompressed size.
+func decompressPage(codec int32, page []byte, size int) ([]byte, error) {
+	if size < 0 {
+		return nil, errMalformed
+	}
+
+	var data []byte
+	var err error
+
+	switch codec {
+	case codecUncompressed:
+		data = page
+
+	case codecSnappy:
+		var decodedSize int
+
+		decodedSize, err = snappy.DecodedLen(page)
+		if err == nil && decodedSize != size {
+			return nil, errMalformed
+		}
+
+		if err == nil {
+			data, err = snappy.Decode(make([]byte, size), page)
+		}
+
+	case codecGzip:
+		var reader *gzip.Reader
+
+		reader, err = gzip.NewReader(bytes.NewReader(page))
+		if err == nil {
+			data, err = io.ReadAll(io.LimitReader(reader, int64(size)+1))
+		}
+
+	case codecZstd:
+		var reader *zstd.Decoder
+
+		reader, err = zstd.NewReader(bytes.NewReader(page), zstd.WithDecoderConcurrency(1))
+		if err == nil {
+			data, err = io.ReadAll(io.LimitReader(reader, int64(size)+1))
+			reader.Close()
+		}
+
+	default:
+		return nil, fmt.Errorf("parquet codec %d is not supported", codec)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to decompress parquet page: %w", err)
+	}
+
+	if len(data) != size {
+		return nil, errMalformed
+	}
+
+	return data, nil
+}
@@ -0,0 +1,406 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/snappy"
+)
+
+// The files in the testdata directory are synthetic, written with a Snappy compressed dictionary
+// and version 1 data pages in two row groups, and with Zstd compressed version 2 data pages in
+// a single row group. They were checked to be read the same by the xitongsys/parquet-go module.
+var fixtureFiles = []string{
+	"testdata/synthetic_snappy_dictionary.parquet",
+	"testdata/synthetic_zstd_data_page_v2.parquet",
+}
+
+func TestReadFixtures(t *testing.T) {
+	start := time.Date(2024, 1, 2, 14, 30, 0, 0, time.UTC)
+
+	var dates []any
+	for i := 0; i < 8; i++ {
+		dates = append(dates, start.Add(time.Duration(i)*5*time.Minute))
+	}
+
+	names := []any{"SP500", "SP500", "NDX", "NDX", "SP500", "SP500", "NDX", "NDX"}
+	closes := []any{4742.83, 4745.2, 16543.94, nil, 4741.1, 4745.2, 16550.12, 16550.12}
+	volumes := []any{int64(1200), int64(980), int64(1500), int64(1100), int64(870), int64(990), int64(1300), int64(1250)}
+
+	for _, fileName := range fixtureFiles {
+		data, err := os.ReadFile(fileName)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		file, err := Open(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		actual := make([][]any, len(file.Columns()))
+
+		for rowGroup := 0; rowGroup < file.RowGroups(); rowGroup++ {
+			for column, schema := range file.Columns() {
+				values, err := file.ReadColumn(rowGroup, column)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				for _, value := range values {
+					switch value := value.(type) {
+					case []byte:
+						actual[column] = append(actual[column], string(value))
+
+					case int64:
+						if schema.TimeUnit == 0 {
+							actual[column] = append(actual[column], value)
+							continue
+						}
+
+						date, err := Time(value, schema.TimeUnit)
+						if err != nil {
+							t.Fatal(err)
+						}
+
+						actual[column] = append(actual[column], date)
+
+					default:
+						actual[column] = append(actual[column], value)
+					}
+				}
+			}
+		}
+
+		expected := [][]any{dates, names, closes, volumes}
+		if !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("%s: actual %v expected %v", fileName, actual, expected)
+		}
+	}
+}
+
+func TestDecodeHybrid(t *testing.T) {
+	// Run of three 5s, followed by a bit-packed group of 1, 2, 3, 0, 1, 2, 3, 0.
+	data := []byte{3 << 1, 5, 1<<1 | 1, 1 | 2<<2 | 3<<4, 1 | 2<<2 | 3<<4}
+
+	actual, err := decodeHybrid(data, 2, 9)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []int32{5, 5, 5, 1, 2, 3, 0, 1, 2}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestReadEncodedPages(t *testing.T) {
+	dates := []time.Time{
+		time.Date(2024, 1, 2, 9, 30, 0, 0, time.UTC),
+		time.Date(2024, 1, 2, 9, 35, 0, 0, time.UTC),
+		time.Date(2024, 1, 2, 9, 40, 0, 0, time.UTC),
+		time.Date(2024, 1, 2, 9, 45, 0, 0, time.UTC),
+	}
+
+	file := []byte(magic)
+
+	// Date column as an uncompressed version 2 data page in microseconds.
+	var dateData []byte
+	for _, date := range dates {
+		dateData = binary.LittleEndian.AppendUint64(dateData, uint64(date.UnixMicro()))
+	}
+
+	dateOffset := int64(len(file))
+	file = appendTestPage(t, file, thriftFields{
+		1: int32(pageDataV2),
+		2: int32(len(dateData)),
+		3: int32(len(dateData)),
+		8: thriftFields{
+			1: int32(4),
+			2: int32(0),
+			3: int32(4),
+			4: int32(encodingPlain),
+			5: int32(0),
+			6: int32(0),
+			7: false,
+		},
+	}, dateData)
+	dateSize := int64(len(file)) - dateOffset
+
+	// Optional Close column as Snappy compressed dictionary and version 1 data pages.
+	var dictionary []byte
+	dictionary = binary.LittleEndian.AppendUint64(dictionary, math.Float64bits(10.5))
+	dictionary = binary.LittleEndian.AppendUint64(dictionary, math.Float64bits(20.5))
+
+	dictionaryOffset := int64(len(file))
+	compressed := snappy.Encode(nil, dictionary)
+	file = appendTestPage(t, file, thriftFields{
+		1: int32(pageDictionary),
+		2: int32(len(dictionary)),
+		3: int32(len(compressed)),
+		7: thriftFields{1: int32(2), 2: int32(encodingPlain)},
+	}, compressed)
+
+	// Definition levels 1, 0, 1, 1 bit-packed, and dictionary indexes 1, 0, 1 as runs.
+	page := binary.LittleEndian.AppendUint32(nil, 2)
+	page = append(page, 1<<1|1, 0b1101)
+	page = append(page, 1, 1<<1, 1, 1<<1, 0, 1<<1, 1)
+
+	closeOffset := int64(len(file))
+	compressed = snappy.Encode(nil, page)
+	file = appendTestPage(t, file, thriftFields{
+		1: int32(pageData),
+		2: int32(len(page)),
+		3: int32(len(compressed)),
+		5: thriftFields{
+			1: int32(4),
+			2: int32(encodingRleDictionary),
+			3: int32(encodingRle),
+			4: int32(encodingRle),
+		},
+	}, compressed)
+	closeSize := int64(len(file)) - dictionaryOffset
+
+	footer, err := encodeThrift(thriftFields{
+		1: int32(1),
+		2: newThriftValues(thriftStruct,
+			thriftFields{4: "schema", 5: int32(2)},
+			thriftFields{
+				1: int32(Int64),
+				3: int32(repetitionRequired),
+				4: "date",
+				10: thriftFields{
+					8: thriftFields{1: true, 2: thriftFields{2: thriftFields{}}},
+				},
+			},
+			thriftFields{1: int32(Double), 3: int32(repetitionOptional), 4: "Close"},
+		),
+		3: int64(4),
+		4: newThriftValues(thriftStruct, thriftFields{
+			1: newThriftValues(thriftStruct,
+				thriftFields{2: dateOffset, 3: thriftFields{
+					1: int32(Int64),
+					3: newThriftValues(thriftBinary, "date"),
+					4: int32(codecUncompressed),
+					5: int64(4),
+					6: dateSize,
+					7: dateSize,
+					9: dateOffset,
+				}},
+				thriftFields{2: dictionaryOffset, 3: thriftFields{
+					1:  int32(Double),
+					3:  newThriftValues(thriftBinary, "Close"),
+					4:  int32(codecSnappy),
+					5:  int64(4),
+					6:  closeSize,
+					7:  closeSize,
+					9:  closeOffset,
+					11: dictionaryOffset,
+				}},
+			),
+			2: dateSize + closeSize,
+			3: int64(4),
+		}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	file = append(file, footer...)
+	file = binary.LittleEndian.AppendUint32(file, uint32(len(footer)))
+	file = append(file, magic...)
+
+	parquetFile, err := Open(bytes.NewReader(file), int64(len(file)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []Column{
+		{Name: "date", Type: Int64, TimeUnit: time.Microsecond},
+		{Name: "Close", Type: Double, Optional: true},
+	}
+
+	if !reflect.DeepEqual(parquetFile.Columns(), expected) {
+		t.Fatalf("actual %v expected %v", parquetFile.Columns(), expected)
+	}
+
+	var expectedDates []any
+	for _, date := range dates {
+		expectedDates = append(expectedDates, date.UnixMicro())
+	}
+
+	tests := []struct {
+		column   int
+		expected []any
+	}{
+		{0, expectedDates},
+		{1, []any{20.5, nil, 10.5, 20.5}},
+	}
+
+	for _, test := range tests {
+		actual, err := parquetFile.ReadColumn(0, test.column)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Fatalf("actual %v expected %v", actual, test.expected)
+		}
+	}
+}
+
+func TestMalformedCounts(t *testing.T) {
+	schema := Column{Name: "Close", Type: Double, Optional: true}
+	levels := binary.LittleEndian.AppendUint32(nil, math.MaxUint32)
+
+	tests := []struct {
+		name   string
+		decode func() error
+	}{
+		{"negative plain count", func() error {
+			_, err := decodePlain(nil, Double, -1)
+			return err
+		}},
+		{"plain count beyond data", func() error {
+			_, err := decodePlain(make([]byte, 8), ByteArray, 3)
+			return err
+		}},
+		{"negative hybrid count", func() error {
+			_, err := decodeHybrid(nil, 1, -1)
+			return err
+		}},
+		{"bit-packed run beyond data", func() error {
+			_, err := decodeHybrid(binary.AppendUvarint(nil, math.MaxInt64), 8, 1)
+			return err
+		}},
+		{"negative page count", func() error {
+			_, err := decodeDataPage(thriftFields{1: int32(-1)}, nil, schema, 10, nil)
+			return err
+		}},
+		{"page count beyond column", func() error {
+			_, err := decodeDataPage(thriftFields{1: int32(11)}, nil, schema, 10, nil)
+			return err
+		}},
+		{"definition levels beyond page", func() error {
+			_, err := decodeDataPage(thriftFields{1: int32(1)}, levels, schema, 10, nil)
+			return err
+		}},
+		{"negative definition levels", func() error {
+			_, err := decodeDataPageV2(thriftFields{8: thriftFields{1: int32(1), 5: int32(-4)}}, nil, codecUncompressed, schema, 10, nil)
+			return err
+		}},
+		{"negative page size", func() error {
+			_, err := decompressPage(codecSnappy, snappy.Encode(nil, levels), -1)
+			return err
+		}},
+		{"page size mismatch", func() error {
+			_, err := decompressPage(codecSnappy, snappy.Encode(nil, levels), 2)
+			return err
+		}},
+	}
+
+	for _, test := range tests {
+		if test.decode() == nil {
+			t.Fatalf("%s: expected error", test.name)
+		}
+	}
+}
+
+func TestCorruptedFiles(t *testing.T) {
+	var written bytes.Buffer
+
+	writer, err := NewWriter(&written, []Column{
+		{Name: "Date", Type: Int64, TimeUnit: time.Millisecond},
+		{Name: "Name", Type: ByteArray},
+		{Name: "Close", Type: Double},
+		{Name: "Volume", Type: Int64},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rowGroups := [][][]any{
+		{
+			{int64(1704153600000), int64(1704240000000), int64(1704326400000), int64(1704412800000)},
+			{"SP500", "SP500", "NDX", "NDX"},
+			{10.5, 11.5, 12.5, 13.5},
+			{int64(100), int64(200), int64(300), int64(400)},
+		},
+		{
+			{int64(1704672000000)},
+			{"SP500"},
+			{14.5},
+			{int64(500)},
+		},
+	}
+
+	for _, rowGroup := range rowGroups {
+		err = writer.WriteRowGroup(rowGroup)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err = writer.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := [][]byte{written.Bytes()}
+
+	for _, fileName := range fixtureFiles {
+		data, err := os.ReadFile(fileName)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		files = append(files, data)
+	}
+
+	// Every single byte corruption should either be read or fail with an error, but never panic.
+	for _, data := range files {
+		for i := range data {
+			for _, mask := range []byte{0x01, 0x80, 0xff} {
+				corrupted := bytes.Clone(data)
+				corrupted[i] ^= mask
+
+				file, err := Open(bytes.NewReader(corrupted), int64(len(corrupted)))
+				if err != nil {
+					continue
+				}
+
+				for rowGroup := 0; rowGroup < file.RowGroups(); rowGroup++ {
+					file.Rows(rowGroup)
+
+					for column := range file.Columns() {
+						file.ColumnMax(rowGroup, column)
+						_, _ = file.ReadColumn(rowGroup, column)
+					}
+				}
+			}
+		}
+	}
+}
+
+// appendTestPage appends the given page with the given header to the given data.
+func appendTestPage(t *testing.T, data []byte, header thriftFields, page []byte) []byte {
+	t.Helper()
+
+	encoded, err := encodeThrift(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data = append(data, encoded...)
+
+	return append(data, page...)
+}
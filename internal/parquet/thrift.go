@@ -0,0 +1,484 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package parquet
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+)
+
+// Thrift compact protocol types.
+const (
+	thriftStop         = 0
+	thriftBooleanTrue  = 1
+	thriftBooleanFalse = 2
+	thriftByte         = 3
+	thriftI16          = 4
+	thriftI32          = 5
+	thriftI64          = 6
+	thriftDouble       = 7
+	thriftBinary       = 8
+	thriftList         = 9
+	thriftSet          = 10
+	thriftMap          = 11
+	thriftStruct       = 12
+)
+
+// errThriftMalformed indicates that the Thrift data is malformed.
+var errThriftMalformed = errors.New("malformed thrift data")
+
+// thriftFields is a Thrift struct as its field values by the field ids. The values are bool,
+// int8, int16, int32, int64, float64, []byte, string, *thriftValues, and thriftFields. Only the
+// field ids and the value types are needed to encode them using the compact protocol.
+type thriftFields map[int16]any
+
+// thriftValues is a Thrift list of values of the same type.
+type thriftValues struct {
+	// elementType is the compact protocol type of the values.
+	elementType byte
+
+	// values are the values of the list.
+	values []any
+}
+
+// newThriftValues returns a new list of the given values with the given element type.
+func newThriftValues[T any](elementType byte, values ...T) *thriftValues {
+	list := &thriftValues{
+		elementType: elementType,
+		values:      make([]any, len(values)),
+	}
+
+	for i, value := range values {
+		list.values[i] = value
+	}
+
+	return list
+}
+
+// i32 returns the int32 field with the given id, or zero if it is not set.
+func (f thriftFields) i32(id int16) int32 {
+	value, _ := f[id].(int32)
+	return value
+}
+
+// i64 returns the int64 field with the given id, or zero if it is not set.
+func (f thriftFields) i64(id int16) int64 {
+	value, _ := f[id].(int64)
+	return value
+}
+
+// binary returns the binary field with the given id, or nil if it is not set.
+func (f thriftFields) binary(id int16) []byte {
+	value, _ := f[id].([]byte)
+	return value
+}
+
+// boolean returns the bool field with the given id, or the given default if it is not set.
+func (f thriftFields) boolean(id int16, defaultValue bool) bool {
+	value, ok := f[id].(bool)
+	if !ok {
+		return defaultValue
+	}
+
+	return value
+}
+
+// fields returns the struct field with the given id, or nil if it is not set.
+func (f thriftFields) fields(id int16) thriftFields {
+	value, _ := f[id].(thriftFields)
+	return value
+}
+
+// list returns the values of the list field with the given id, or nil if it is not set.
+func (f thriftFields) list(id int16) []any {
+	value, ok := f[id].(*thriftValues)
+	if !ok {
+		return nil
+	}
+
+	return value.values
+}
+
+// thriftEncoder encodes the Thrift values using the compact protocol.
+type thriftEncoder struct {
+	// data is the encoded data.
+	data []byte
+}
+
+// encodeThrift encodes the given struct using the compact protocol.
+func encodeThrift(fields thriftFields) ([]byte, error) {
+	encoder := &thriftEncoder{}
+
+	err := encoder.writeStruct(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return encoder.data, nil
+}
+
+// writeStruct writes the given struct fields in the order of their ids.
+func (e *thriftEncoder) writeStruct(fields thriftFields) error {
+	ids := make([]int16, 0, len(fields))
+	for id := range fields {
+		ids = append(ids, id)
+	}
+
+	slices.Sort(ids)
+
+	var last int16
+
+	for _, id := range ids {
+		value := fields[id]
+
+		fieldType, err := thriftTypeOf(value)
+		if err != nil {
+			return fmt.Errorf("field %d: %w", id, err)
+		}
+
+		// The bool values are encoded within their types.
+		if fieldType == thriftBooleanTrue && !value.(bool) {
+			fieldType = thriftBooleanFalse
+		}
+
+		if delta := id - last; delta > 0 && delta <= 15 {
+			e.data = append(e.data, byte(delta)<<4|fieldType)
+		} else {
+			e.data = append(e.data, fieldType)
+			e.writeVarint(int64(id))
+		}
+
+		last = id
+
+		if fieldType == thriftBooleanTrue || fieldType == thriftBooleanFalse {
+			continue
+		}
+
+		err = e.writeValue(value)
+		if err != nil {
+			return fmt.Errorf("field %d: %w", id, err)
+		}
+	}
+
+	e.data = append(e.data, thriftStop)
+
+	return nil
+}
+
+// writeValue writes the given value.
+func (e *thriftEncoder) writeValue(value any) error {
+	switch value := value.(type) {
+	case bool:
+		if value {
+			e.data = append(e.data, thriftBooleanTrue)
+		} else {
+			e.data = append(e.data, thriftBooleanFalse)
+		}
+
+	case int8:
+		e.data = append(e.data, byte(value))
+
+	case int16:
+		e.writeVarint(int64(value))
+
+	case int32:
+		e.writeVarint(int64(value))
+
+	case int64:
+		e.writeVarint(value)
+
+	case float64:
+		e.data = binary.LittleEndian.AppendUint64(e.data, math.Float64bits(value))
+
+	case []byte:
+		e.data = binary.AppendUvarint(e.data, uint64(len(value)))
+		e.data = append(e.data, value...)
+
+	case string:
+		e.data = binary.AppendUvarint(e.data, uint64(len(value)))
+		e.data = append(e.data, value...)
+
+	case *thriftValues:
+		if len(value.values) < 15 {
+			e.data = append(e.data, byte(len(value.values))<<4|value.elementType)
+		} else {
+			e.data = append(e.data, 0xf0|value.elementType)
+			e.data = binary.AppendUvarint(e.data, uint64(len(value.values)))
+		}
+
+		for _, element := range value.values {
+			err := e.writeValue(element)
+			if err != nil {
+				return err
+			}
+		}
+
+	case thriftFields:
+		return e.writeStruct(value)
+
+	default:
+		return fmt.Errorf("unsupported thrift value %T", value)
+	}
+
+	return nil
+}
+
+// writeVarint writes the given integer as a zigzag varint.
+func (e *thriftEncoder) writeVarint(value int64) {
+	e.data = binary.AppendUvarint(e.data, uint64(value<<1)^uint64(value>>63))
+}
+
+// thriftTypeOf returns the compact protocol type of the given value.
+func thriftTypeOf(value any) (byte, error) {
+	switch value.(type) {
+	case bool:
+		return thriftBooleanTrue, nil
+
+	case int8:
+		return thriftByte, nil
+
+	case int16:
+		return thriftI16, nil
+
+	case int32:
+		return thriftI32, nil
+
+	case int64:
+		return thriftI64, nil
+
+	case float64:
+		return thriftDouble, nil
+
+	case []byte, string:
+		return thriftBinary, nil
+
+	case *thriftValues:
+		return thriftList, nil
+
+	case thriftFields:
+		return thriftStruct, nil
+
+	default:
+		return 0, fmt.Errorf("unsupported thrift value %T", value)
+	}
+}
+
+// thriftDecoder decodes the Thrift values encoded using the compact protocol.
+type thriftDecoder struct {
+	// data is the encoded data.
+	data []byte
+
+	// offset is the offset of the next byte to decode.
+	offset int
+}
+
+// decodeThrift decodes a struct from the beginning of the given data, and returns
+// it along with the number of bytes it is encoded in.
+func decodeThrift(data []byte) (thriftFields, int, error) {
+	decoder := &thriftDecoder{
+		data: data,
+	}
+
+	fields, err := decoder.readStruct()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return fields, decoder.offset, nil
+}
+
+// readStruct reads the fields of a struct until its stop field.
+func (d *thriftDecoder) readStruct() (thriftFields, error) {
+	fields := thriftFields{}
+
+	var last int16
+
+	for {
+		header, err := d.readByte()
+		if err != nil {
+			return nil, err
+		}
+
+		fieldType := header & 0x0f
+		if fieldType == thriftStop {
+			return fields, nil
+		}
+
+		id := last + int16(header>>4)
+		if header>>4 == 0 {
+			value, err := d.readVarint()
+			if err != nil {
+				return nil, err
+			}
+
+			id = int16(value)
+		}
+
+		last = id
+
+		switch fieldType {
+		case thriftBooleanTrue:
+			fields[id] = true
+
+		case thriftBooleanFalse:
+			fields[id] = false
+
+		default:
+			fields[id], err = d.readValue(fieldType)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// readValue reads a value of the given type.
+func (d *thriftDecoder) readValue(valueType byte) (any, error) {
+	switch valueType {
+	case thriftBooleanTrue, thriftBooleanFalse:
+		value, err := d.readByte()
+		return value == thriftBooleanTrue, err
+
+	case thriftByte:
+		value, err := d.readByte()
+		return int8(value), err
+
+	case thriftI16:
+		value, err := d.readVarint()
+		return int16(value), err
+
+	case thriftI32:
+		value, err := d.readVarint()
+		return int32(value), err
+
+	case thriftI64:
+		return d.readVarint()
+
+	case thriftDouble:
+		if d.offset+8 > len(d.data) {
+			return nil, errThriftMalformed
+		}
+
+		value := math.Float64frombits(binary.LittleEndian.Uint64(d.data[d.offset:]))
+		d.offset += 8
+
+		return value, nil
+
+	case thriftBinary:
+		length, err := d.readLength()
+		if err != nil {
+			return nil, err
+		}
+
+		value := d.data[d.offset : d.offset+length]
+		d.offset += length
+
+		return value, nil
+
+	case thriftList, thriftSet:
+		header, err := d.readByte()
+		if err != nil {
+			return nil, err
+		}
+
+		list := &thriftValues{
+			elementType: header & 0x0f,
+		}
+
+		size := int(header >> 4)
+		if size == 15 {
+			size, err = d.readLength()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for i := 0; i < size; i++ {
+			value, err := d.readValue(list.elementType)
+			if err != nil {
+				return nil, err
+			}
+
+			list.values = append(list.values, value)
+		}
+
+		return list, nil
+
+	case thriftMap:
+		return d.readMap()
+
+	case thriftStruct:
+		return d.readStruct()
+
+	default:
+		return nil, errThriftMalformed
+	}
+}
+
+// readMap reads a map, which is not used by the decoded structs, and returns nil.
+func (d *thriftDecoder) readMap() (any, error) {
+	size, err := d.readLength()
+	if err != nil || size == 0 {
+		return nil, err
+	}
+
+	types, err := d.readByte()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < size; i++ {
+		_, err = d.readValue(types >> 4)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = d.readValue(types & 0x0f)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+// readByte reads a single byte.
+func (d *thriftDecoder) readByte() (byte, error) {
+	if d.offset >= len(d.data) {
+		return 0, errThriftMalformed
+	}
+
+	value := d.data[d.offset]
+	d.offset++
+
+	return value, nil
+}
+
+// readVarint reads a zigzag varint.
+func (d *thriftDecoder) readVarint() (int64, error) {
+	value, n := binary.Uvarint(d.data[d.offset:])
+	if n <= 0 {
+		return 0, errThriftMalformed
+	}
+
+	d.offset += n
+
+	return int64(value>>1) ^ -int64(value&1), nil
+}
+
+// readLength reads a length as an unsigned varint, and checks that it fits in the remaining data.
+func (d *thriftDecoder) readLength() (int, error) {
+	value, n := binary.Uvarint(d.data[d.offset:])
+	if n <= 0 || value > uint64(len(d.data)-d.offset-n) {
+		return 0, errThriftMalformed
+	}
+
+	d.offset += n
+
+	return int(value), nil
+}
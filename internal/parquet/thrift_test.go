@@ -0,0 +1,53 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package parquet
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestThriftRoundTrip(t *testing.T) {
+	expected := thriftFields{
+		1:  int32(-5),
+		2:  int64(1 << 40),
+		3:  []byte("name"),
+		4:  true,
+		5:  false,
+		7:  1.5,
+		30: newThriftValues(thriftI32, int32(1), int32(2)),
+		31: thriftFields{1: int16(7), 2: int8(-1)},
+	}
+
+	data, err := encodeThrift(expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual, n, err := decodeThrift(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n != len(data) {
+		t.Fatalf("actual %d expected %d", n, len(data))
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestThriftMalformed(t *testing.T) {
+	data, err := encodeThrift(thriftFields{1: "name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err = decodeThrift(data[:len(data)-2])
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
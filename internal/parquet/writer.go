@@ -0,0 +1,269 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package parquet
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"time"
+)
+
+// Writer writes the rows to a Parquet file as row groups, with a single uncompressed page using
+// the plain encoding for each column, along with the statistics of each row group. The columns
+// are required, and their values are int64 for the Int64 columns, float64 for the Double columns,
+// and string for the ByteArray columns.
+type Writer struct {
+	// writer is the underlying writer.
+	writer *bufio.Writer
+
+	// offset is the number of bytes written.
+	offset int64
+
+	// columns are the columns in the schema of the file.
+	columns []Column
+
+	// rowGroups are the metadata of the row groups written.
+	rowGroups []thriftFields
+
+	// rows is the number of rows written.
+	rows int64
+}
+
+// NewWriter function initializes a new writer with the given columns, and writes the beginning
+// of the file to the given writer.
+func NewWriter(writer io.Writer, columns []Column) (*Writer, error) {
+	for _, column := range columns {
+		if column.Type != Int64 && column.Type != Double && column.Type != ByteArray {
+			return nil, fmt.Errorf("parquet type %d of column %s is not supported for writing", column.Type, column.Name)
+		}
+	}
+
+	w := &Writer{
+		writer:  bufio.NewWriter(writer),
+		columns: columns,
+	}
+
+	err := w.write([]byte(magic))
+	if err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// WriteRowGroup writes the given values, given for each column, as a row group.
+func (w *Writer) WriteRowGroup(values [][]any) error {
+	if len(values) != len(w.columns) {
+		return fmt.Errorf("values of %d columns given instead of %d", len(values), len(w.columns))
+	}
+
+	var chunks []thriftFields
+
+	start := w.offset
+	rows := 0
+
+	for i, column := range w.columns {
+		if i > 0 && len(values[i]) != rows {
+			return fmt.Errorf("column %s has %d values instead of %d", column.Name, len(values[i]), rows)
+		}
+
+		rows = len(values[i])
+
+		var data []byte
+		var minValue, maxValue any
+
+		for _, value := range values[i] {
+			data = encodePlain(data, column.Type, value)
+
+			if minValue == nil || less(value, minValue) {
+				minValue = value
+			}
+
+			if maxValue == nil || less(maxValue, value) {
+				maxValue = value
+			}
+		}
+
+		header, err := encodeThrift(thriftFields{
+			1: int32(pageData),
+			2: int32(len(data)),
+			3: int32(len(data)),
+			5: thriftFields{
+				1: int32(rows),
+				2: int32(encodingPlain),
+				3: int32(encodingRle),
+				4: int32(encodingRle),
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		offset := w.offset
+
+		err = w.write(append(header, data...))
+		if err != nil {
+			return err
+		}
+
+		metadata := thriftFields{
+			1: int32(column.Type),
+			2: newThriftValues(thriftI32, int32(encodingPlain), int32(encodingRle)),
+			3: newThriftValues(thriftBinary, column.Name),
+			4: int32(codecUncompressed),
+			5: int64(rows),
+			6: w.offset - offset,
+			7: w.offset - offset,
+			9: offset,
+		}
+
+		if minValue != nil && !isNaN(minValue) && !isNaN(maxValue) {
+			metadata[12] = thriftFields{
+				5: statistic(column.Type, maxValue),
+				6: statistic(column.Type, minValue),
+			}
+		}
+
+		chunks = append(chunks, thriftFields{
+			2: offset,
+			3: metadata,
+		})
+	}
+
+	w.rowGroups = append(w.rowGroups, thriftFields{
+		1: newThriftValues(thriftStruct, chunks...),
+		2: w.offset - start,
+		3: int64(rows),
+	})
+
+	w.rows += int64(rows)
+
+	return nil
+}
+
+// Close writes the metadata of the row groups at the end of the file, and flushes the
+// underlying writer, which is not closed.
+func (w *Writer) Close() error {
+	footer, err := encodeThrift(thriftFields{
+		1: int32(1),
+		2: newThriftValues(thriftStruct, w.schema()...),
+		3: w.rows,
+		4: newThriftValues(thriftStruct, w.rowGroups...),
+		6: "indicator",
+	})
+	if err != nil {
+		return err
+	}
+
+	footer = binary.LittleEndian.AppendUint32(footer, uint32(len(footer)))
+	footer = append(footer, magic...)
+
+	err = w.write(footer)
+	if err != nil {
+		return err
+	}
+
+	return w.writer.Flush()
+}
+
+// write writes the given data to the underlying writer, counting the bytes written to track
+// the offsets in the file.
+func (w *Writer) write(data []byte) error {
+	n, err := w.writer.Write(data)
+	w.offset += int64(n)
+
+	return err
+}
+
+// schema returns the schema elements of the columns along with their root.
+func (w *Writer) schema() []thriftFields {
+	elements := []thriftFields{
+		{
+			4: "schema",
+			5: int32(len(w.columns)),
+		},
+	}
+
+	for _, column := range w.columns {
+		element := thriftFields{
+			1: int32(column.Type),
+			3: int32(repetitionRequired),
+			4: column.Name,
+		}
+
+		switch {
+		case column.TimeUnit == time.Millisecond:
+			element[6] = int32(convertedTimestampMillis)
+			element[10] = thriftFields{
+				8: thriftFields{
+					1: true,
+					2: thriftFields{1: thriftFields{}},
+				},
+			}
+
+		case column.Type == ByteArray:
+			element[6] = int32(convertedUtf8)
+			element[10] = thriftFields{1: thriftFields{}}
+		}
+
+		elements = append(elements, element)
+	}
+
+	return elements
+}
+
+// encodePlain encodes the given value of the given physical type using the plain encoding.
+func encodePlain(data []byte, physicalType Type, value any) []byte {
+	switch physicalType {
+	case Int64:
+		return binary.LittleEndian.AppendUint64(data, uint64(value.(int64)))
+
+	case Double:
+		return binary.LittleEndian.AppendUint64(data, math.Float64bits(value.(float64)))
+
+	case ByteArray:
+		data = binary.LittleEndian.AppendUint32(data, uint32(len(value.(string))))
+		return append(data, value.(string)...)
+
+	default:
+		return data
+	}
+}
+
+// less checks if the first value is less than the second value of the same type.
+func less(a, b any) bool {
+	switch a := a.(type) {
+	case int64:
+		return a < b.(int64)
+
+	case float64:
+		return a < b.(float64)
+
+	case string:
+		return a < b.(string)
+
+	default:
+		return false
+	}
+}
+
+// isNaN checks if the given value is a NaN.
+func isNaN(value any) bool {
+	number, ok := value.(float64)
+	return ok && number != number
+}
+
+// statistic encodes the given statistic value. Unlike the plain encoding, the
+// byte arrays are not length prefixed.
+func statistic(physicalType Type, value any) []byte {
+	if physicalType == ByteArray {
+		return []byte(value.(string))
+	}
+
+	return encodePlain(nil, physicalType, value)
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package parquet_test
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/miromax42/indicator/v2/internal/parquet"
+)
+
+func TestWriterRoundTrip(t *testing.T) {
+	columns := []parquet.Column{
+		{Name: "Date", Type: parquet.Int64, TimeUnit: time.Millisecond},
+		{Name: "Name", Type: parquet.ByteArray},
+		{Name: "Close", Type: parquet.Double},
+	}
+
+	rowGroups := [][][]any{
+		{
+			{int64(1704153600000), int64(1704240000000)},
+			{"SP500", "NDX"},
+			{10.5, 9.5},
+		},
+		{
+			{int64(1704326400000)},
+			{"SP500"},
+			{math.NaN()},
+		},
+	}
+
+	var buffer bytes.Buffer
+
+	writer, err := parquet.NewWriter(&buffer, columns)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, rowGroup := range rowGroups {
+		err = writer.WriteRowGroup(rowGroup)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err = writer.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := parquet.Open(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(file.Columns(), columns) {
+		t.Fatalf("actual %v expected %v", file.Columns(), columns)
+	}
+
+	if file.RowGroups() != len(rowGroups) {
+		t.Fatalf("actual %v expected %v", file.RowGroups(), len(rowGroups))
+	}
+
+	for rowGroup := range rowGroups {
+		if file.Rows(rowGroup) != len(rowGroups[rowGroup][0]) {
+			t.Fatalf("actual %v expected %v", file.Rows(rowGroup), len(rowGroups[rowGroup][0]))
+		}
+
+		date, err := file.ReadColumn(rowGroup, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(date, rowGroups[rowGroup][0]) {
+			t.Fatalf("actual %v expected %v", date, rowGroups[rowGroup][0])
+		}
+
+		names, err := file.ReadColumn(rowGroup, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i, name := range names {
+			if string(name.([]byte)) != rowGroups[rowGroup][1][i] {
+				t.Fatalf("actual %v expected %v", name, rowGroups[rowGroup][1][i])
+			}
+		}
+	}
+
+	tests := []struct {
+		rowGroup int
+		column   int
+		expected any
+		ok       bool
+	}{
+		{0, 0, int64(1704240000000), true},
+		{0, 1, nil, false},
+		{0, 2, 10.5, true},
+		{1, 2, nil, false},
+	}
+
+	for _, test := range tests {
+		actual, ok := file.ColumnMax(test.rowGroup, test.column)
+		if ok != test.ok || actual != test.expected {
+			t.Fatalf("actual %v %v expected %v %v", actual, ok, test.expected, test.ok)
+		}
+	}
+}
+
+func TestWriterUnsupportedType(t *testing.T) {
+	_, err := parquet.NewWriter(&bytes.Buffer{}, []parquet.Column{
+		{Name: "Flag", Type: parquet.Boolean},
+	})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestWriterColumnMismatch(t *testing.T) {
+	writer, err := parquet.NewWriter(&bytes.Buffer{}, []parquet.Column{
+		{Name: "Close", Type: parquet.Double},
+		{Name: "Volume", Type: parquet.Int64},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := [][][]any{
+		{{1.5}},
+		{{1.5, 2.5}, {int64(1)}},
+	}
+
+	for _, test := range tests {
+		err = writer.WriteRowGroup(test)
+		if err == nil {
+			t.Fatalf("%v: expected error", test)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package metrics
+
+import "math"
+
+// Cagr computes the compound annual growth rate of the given equity curve,
+// starting with a single unit of capital.
+//
+//	CAGR = Pow(Equity[n-1], PeriodsPerYear / n) - 1
+func Cagr(equities []float64, periodsPerYear int) float64 {
+	if len(equities) == 0 {
+		return 0
+	}
+
+	last := equities[len(equities)-1]
+	if last <= 0 {
+		return -1
+	}
+
+	return math.Pow(last, float64(periodsPerYear)/float64(len(equities))) - 1
+}
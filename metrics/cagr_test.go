@@ -0,0 +1,26 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/metrics"
+)
+
+func TestCagr(t *testing.T) {
+	equities := []float64{1, 1.1, 1.21, 1.21}
+
+	actual := helper.RoundDigit(metrics.Cagr(equities, 2), 2)
+	if actual != 0.1 {
+		t.Fatalf("actual %v expected %v", actual, 0.1)
+	}
+
+	actual = metrics.Cagr([]float64{1, 0}, 2)
+	if actual != -1 {
+		t.Fatalf("actual %v expected %v", actual, -1)
+	}
+}
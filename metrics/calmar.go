@@ -0,0 +1,17 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package metrics
+
+// Calmar computes the Calmar ratio from the given compound annual growth rate and
+// the maximum drawdown. It is zero when there is no drawdown.
+//
+//	Calmar = CAGR / Max Drawdown
+func Calmar(cagr, maxDrawdown float64) float64 {
+	if maxDrawdown == 0 {
+		return 0
+	}
+
+	return cagr / maxDrawdown
+}
@@ -0,0 +1,23 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/miromax42/indicator/v2/metrics"
+)
+
+func TestCalmar(t *testing.T) {
+	actual := metrics.Calmar(0.2, 0.1)
+	if actual != 2 {
+		t.Fatalf("actual %v expected %v", actual, 2)
+	}
+
+	actual = metrics.Calmar(0.2, 0)
+	if actual != 0 {
+		t.Fatalf("actual %v expected %v", actual, 0)
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package metrics
+
+// MaxDrawdown computes the maximum decline of the given equity curve from a
+// preceding peak as a fraction of the peak, and the longest number of periods
+// the equity curve stayed below a preceding peak.
+//
+//	Drawdown[i] = 1 - Equity[i] / Max(Equity[0..i])
+func MaxDrawdown(equities []float64) (float64, int) {
+	maxDrawdown := 0.0
+	maxDuration := 0
+
+	peak := 0.0
+	duration := 0
+
+	for i, equity := range equities {
+		if i == 0 || equity >= peak {
+			peak = equity
+			duration = 0
+			continue
+		}
+
+		duration++
+		maxDuration = max(maxDuration, duration)
+
+		if peak > 0 {
+			maxDrawdown = max(maxDrawdown, 1-equity/peak)
+		}
+	}
+
+	return maxDrawdown, maxDuration
+}
@@ -0,0 +1,27 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/metrics"
+)
+
+func TestMaxDrawdown(t *testing.T) {
+	equities := []float64{1, 1.2, 1.08, 0.9, 1.3, 1.17, 1.4}
+
+	drawdown, duration := metrics.MaxDrawdown(equities)
+
+	drawdown = helper.RoundDigit(drawdown, 2)
+	if drawdown != 0.25 {
+		t.Fatalf("actual %v expected %v", drawdown, 0.25)
+	}
+
+	if duration != 2 {
+		t.Fatalf("actual %v expected %v", duration, 2)
+	}
+}
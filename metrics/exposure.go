@@ -0,0 +1,32 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package metrics
+
+import "github.com/miromax42/indicator/v2/strategy"
+
+// Exposure computes the fraction of the periods spent in a long or a short position
+// by following the given actions, entering a position on a Buy or a Short and exiting
+// it on the following Sell. The period of the entry counts as exposed, and the period
+// of the exit does not.
+func Exposure(actions []strategy.Action) float64 {
+	if len(actions) == 0 {
+		return 0
+	}
+
+	exposed := 0
+	position := strategy.Sell
+
+	for _, action := range actions {
+		if action != strategy.Hold {
+			position = action
+		}
+
+		if position != strategy.Sell {
+			exposed++
+		}
+	}
+
+	return float64(exposed) / float64(len(actions))
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/miromax42/indicator/v2/metrics"
+	"github.com/miromax42/indicator/v2/strategy"
+)
+
+func TestExposure(t *testing.T) {
+	actions := []strategy.Action{
+		strategy.Hold, strategy.Buy, strategy.Hold, strategy.Sell,
+	}
+
+	actual := metrics.Exposure(actions)
+	if actual != 0.5 {
+		t.Fatalf("actual %v expected %v", actual, 0.5)
+	}
+}
+
+func TestExposureShort(t *testing.T) {
+	actions := []strategy.Action{
+		strategy.Short, strategy.Hold, strategy.Buy, strategy.Sell,
+	}
+
+	actual := metrics.Exposure(actions)
+	if actual != 0.75 {
+		t.Fatalf("actual %v expected %v", actual, 0.75)
+	}
+}
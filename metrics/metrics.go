@@ -0,0 +1,196 @@
+// Package metrics contains the risk and performance metrics functions.
+//
+// This package belongs to the Indicator project. Indicator is
+// a Golang module that supplies a variety of technical
+// indicators, strategies, and a backtesting framework
+// for analysis.
+//
+// # License
+//
+//	Copyright (c) 2021-2024 Onur Cinar.
+//	The source code is provided under GNU AGPLv3 License.
+//	https://github.com/cinar/indicator
+//
+// # Disclaimer
+//
+// The information provided on this project is strictly for
+// informational purposes and is not to be construed as
+// advice or solicitation to buy or sell any security.
+package metrics
+
+import (
+	"math"
+	"time"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/strategy"
+)
+
+const (
+	// DefaultPeriodsPerYear is the default number of periods in a year, the trading days.
+	DefaultPeriodsPerYear = 252
+
+	// DefaultRiskFreeRate is the default annual risk free rate.
+	DefaultRiskFreeRate = 0
+
+	// DefaultTradingSession is the default length of the trading session of a day.
+	DefaultTradingSession = 6*time.Hour + 30*time.Minute
+
+	// calendarDaysPerYear is the average number of calendar days in a year.
+	calendarDaysPerYear = 365.25
+)
+
+// Metrics is the risk and performance metrics of a strategy.
+type Metrics struct {
+	// Cagr is the compound annual growth rate.
+	Cagr float64
+
+	// Volatility is the annualized volatility of the returns.
+	Volatility float64
+
+	// Sharpe is the annualized Sharpe ratio.
+	Sharpe float64
+
+	// Sortino is the annualized Sortino ratio.
+	Sortino float64
+
+	// Calmar is the Calmar ratio.
+	Calmar float64
+
+	// MaxDrawdown is the maximum decline from a peak as a fraction of the peak.
+	MaxDrawdown float64
+
+	// MaxDrawdownDuration is the longest number of periods spent below a peak.
+	MaxDrawdownDuration int
+
+	// WinRate is the fraction of the trades that are profitable.
+	WinRate float64
+
+	// ProfitFactor is the gross profit divided by the gross loss. It is positive
+	// infinity when there are winning trades but no losing trades.
+	ProfitFactor float64
+
+	// AverageWin is the average return of the profitable trades.
+	AverageWin float64
+
+	// AverageLoss is the average return of the losing trades.
+	AverageLoss float64
+
+	// Exposure is the fraction of the periods spent in a long or a short position.
+	Exposure float64
+
+	// Trades is the number of trades.
+	Trades int
+}
+
+// Calculator computes the risk and performance metrics from the outcomes
+// and the actions of a strategy.
+type Calculator struct {
+	// PeriodsPerYear is the number of periods in a year, the trading days for the daily bars.
+	PeriodsPerYear int
+
+	// RiskFreeRate is the annual risk free rate.
+	RiskFreeRate float64
+
+	// TradingSession is the length of the trading session of a day, used to count the
+	// intraday bars in a trading day.
+	TradingSession time.Duration
+}
+
+// NewCalculator function initializes a new metrics calculator instance with the default parameters.
+func NewCalculator() *Calculator {
+	return NewCalculatorWith(DefaultPeriodsPerYear, DefaultRiskFreeRate)
+}
+
+// NewCalculatorWith function initializes a new metrics calculator instance with the given parameters.
+func NewCalculatorWith(periodsPerYear int, riskFreeRate float64) *Calculator {
+	return &Calculator{
+		PeriodsPerYear: periodsPerYear,
+		RiskFreeRate:   riskFreeRate,
+		TradingSession: DefaultTradingSession,
+	}
+}
+
+// ForInterval returns a copy of the calculator with the periods per year of the bars with the
+// given interval. The intraday bars are counted over the trading sessions of the trading days,
+// and the bars longer than a day over the calendar year. The calculator is returned as is for
+// the daily bars, and for the zero interval when it is not known.
+//
+// Example:
+//
+//	calculator := metrics.NewCalculator().ForInterval(asset.FiveMinutes)
+//	fmt.Println(calculator.PeriodsPerYear) // 19656
+func (c *Calculator) ForInterval(interval asset.Interval) *Calculator {
+	if interval <= 0 || interval == asset.OneDay {
+		return c
+	}
+
+	calculator := *c
+
+	if interval.IsIntraday() {
+		bars := int((c.TradingSession + interval.Duration() - 1) / interval.Duration())
+		calculator.PeriodsPerYear = c.PeriodsPerYear * max(bars, 1)
+	} else {
+		calculator.PeriodsPerYear = int(math.Round(calendarDaysPerYear * float64(asset.OneDay) / float64(interval)))
+	}
+
+	return &calculator
+}
+
+// Compute takes the outcomes, as generated by the Outcome function, and the actions
+// of a strategy, reading them in lockstep, and computes the metrics. A position
+// that is still open at the end is counted as a trade closed at the last outcome.
+func (c *Calculator) Compute(outcomes <-chan float64, actions <-chan strategy.Action) *Metrics {
+	return c.ComputeFromEquities(Collect(outcomes, actions))
+}
+
+// Collect takes the outcomes, as generated by the Outcome function, and the actions of a
+// strategy, reading them in lockstep, and returns the equity curve, starting with a single
+// unit of capital, and the actions that produced it.
+func Collect(outcomes <-chan float64, actions <-chan strategy.Action) ([]float64, []strategy.Action) {
+	equities := []float64{}
+	actionsSlice := []strategy.Action{}
+
+	for outcome := range outcomes {
+		action, ok := <-actions
+		if !ok {
+			break
+		}
+
+		// The outcomes are relative to a single unit of capital.
+		equities = append(equities, 1+outcome)
+		actionsSlice = append(actionsSlice, action)
+	}
+
+	go helper.Drain(outcomes)
+	helper.Drain(actions)
+
+	return equities, actionsSlice
+}
+
+// ComputeFromEquities computes the metrics from the given equity curve, starting
+// with a single unit of capital, and the actions that produced it.
+func (c *Calculator) ComputeFromEquities(equities []float64, actions []strategy.Action) *Metrics {
+	returns := Returns(equities)
+	trades := TradeReturns(equities, actions)
+
+	maxDrawdown, maxDrawdownDuration := MaxDrawdown(equities)
+	cagr := Cagr(equities, c.PeriodsPerYear)
+
+	metrics := &Metrics{
+		Cagr:                cagr,
+		Volatility:          Volatility(returns, c.PeriodsPerYear),
+		Sharpe:              Sharpe(returns, c.RiskFreeRate, c.PeriodsPerYear),
+		Sortino:             Sortino(returns, c.RiskFreeRate, c.PeriodsPerYear),
+		Calmar:              Calmar(cagr, maxDrawdown),
+		MaxDrawdown:         maxDrawdown,
+		MaxDrawdownDuration: maxDrawdownDuration,
+		Exposure:            Exposure(actions),
+		Trades:              len(trades),
+	}
+
+	metrics.WinRate, metrics.ProfitFactor, metrics.AverageWin, metrics.AverageLoss = TradeStats(trades)
+
+	return metrics
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/metrics"
+	"github.com/miromax42/indicator/v2/strategy"
+)
+
+func TestCalculator(t *testing.T) {
+	values := helper.SliceToChan([]float64{10, 12, 9, 11})
+	actions := helper.Duplicate(helper.SliceToChan([]strategy.Action{
+		strategy.Buy, strategy.Hold, strategy.Hold, strategy.Sell,
+	}), 2)
+
+	outcomes := strategy.Outcome(values, actions[0])
+
+	actual := metrics.NewCalculatorWith(4, 0).Compute(outcomes, actions[1])
+
+	if actual.Trades != 1 {
+		t.Fatalf("actual %v expected %v", actual.Trades, 1)
+	}
+
+	if actual.WinRate != 1 {
+		t.Fatalf("actual %v expected %v", actual.WinRate, 1)
+	}
+
+	if helper.RoundDigit(actual.Cagr, 2) != 0.1 {
+		t.Fatalf("actual %v expected %v", actual.Cagr, 0.1)
+	}
+
+	if helper.RoundDigit(actual.MaxDrawdown, 2) != 0.25 {
+		t.Fatalf("actual %v expected %v", actual.MaxDrawdown, 0.25)
+	}
+
+	if actual.MaxDrawdownDuration != 2 {
+		t.Fatalf("actual %v expected %v", actual.MaxDrawdownDuration, 2)
+	}
+
+	if actual.Exposure != 0.75 {
+		t.Fatalf("actual %v expected %v", actual.Exposure, 0.75)
+	}
+}
+
+func TestCalculatorEmpty(t *testing.T) {
+	outcomes := helper.SliceToChan([]float64{})
+	actions := helper.SliceToChan([]strategy.Action{})
+
+	actual := metrics.NewCalculator().Compute(outcomes, actions)
+
+	if *actual != (metrics.Metrics{}) {
+		t.Fatalf("actual %v expected zero metrics", *actual)
+	}
+}
+
+func TestCalculatorForInterval(t *testing.T) {
+	tests := []struct {
+		interval asset.Interval
+		expected int
+	}{
+		{0, metrics.DefaultPeriodsPerYear},
+		{asset.OneDay, metrics.DefaultPeriodsPerYear},
+		{asset.FiveMinutes, metrics.DefaultPeriodsPerYear * 78},
+		{asset.OneHour, metrics.DefaultPeriodsPerYear * 7},
+		{asset.OneWeek, 52},
+	}
+
+	calculator := metrics.NewCalculator()
+
+	for _, test := range tests {
+		actual := calculator.ForInterval(test.interval).PeriodsPerYear
+		if actual != test.expected {
+			t.Fatalf("actual %v expected %v for %v", actual, test.expected, test.interval)
+		}
+	}
+
+	if calculator.PeriodsPerYear != metrics.DefaultPeriodsPerYear {
+		t.Fatalf("actual %v expected %v", calculator.PeriodsPerYear, metrics.DefaultPeriodsPerYear)
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package metrics
+
+// Returns computes the period returns of the given equity curve. The return of
+// a period following a non-positive equity is zero.
+//
+//	Return[i] = Equity[i] / Equity[i-1] - 1
+func Returns(equities []float64) []float64 {
+	if len(equities) < 2 {
+		return []float64{}
+	}
+
+	returns := make([]float64, len(equities)-1)
+
+	for i := 1; i < len(equities); i++ {
+		if equities[i-1] > 0 {
+			returns[i-1] = equities[i]/equities[i-1] - 1
+		}
+	}
+
+	return returns
+}
@@ -0,0 +1,24 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/metrics"
+)
+
+func TestReturns(t *testing.T) {
+	equities := []float64{1, 1.1, 0.99, 0, 1}
+	expected := helper.SliceToChan([]float64{0.1, -0.1, -1, 0})
+
+	actual := helper.RoundDigits(helper.SliceToChan(metrics.Returns(equities)), 2)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package metrics
+
+import "math"
+
+// Sharpe computes the annualized Sharpe ratio of the given returns. It is zero
+// when the returns do not vary.
+//
+//	Sharpe = (Mean(Returns) - RiskFreeRate / PeriodsPerYear) / Std(Returns) * Sqrt(PeriodsPerYear)
+func Sharpe(returns []float64, riskFreeRate float64, periodsPerYear int) float64 {
+	deviation := std(returns)
+	if deviation == 0 {
+		return 0
+	}
+
+	excess := mean(returns) - riskFreeRate/float64(periodsPerYear)
+
+	return excess / deviation * math.Sqrt(float64(periodsPerYear))
+}
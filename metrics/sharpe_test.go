@@ -0,0 +1,31 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/metrics"
+)
+
+func TestSharpe(t *testing.T) {
+	returns := []float64{0.3, -0.1}
+
+	actual := helper.RoundDigit(metrics.Sharpe(returns, 0, 4), 2)
+	if actual != 1 {
+		t.Fatalf("actual %v expected %v", actual, 1)
+	}
+
+	actual = helper.RoundDigit(metrics.Sharpe(returns, 0.2, 4), 2)
+	if actual != 0.5 {
+		t.Fatalf("actual %v expected %v", actual, 0.5)
+	}
+
+	actual = metrics.Sharpe([]float64{0.1, 0.1}, 0, 4)
+	if actual != 0 {
+		t.Fatalf("actual %v expected %v", actual, 0)
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package metrics
+
+import "math"
+
+// Sortino computes the annualized Sortino ratio of the given returns, which only
+// penalizes the returns below the risk free rate. It is zero when there are no
+// such returns.
+//
+//	Downside = Sqrt(Mean(Pow(Min(Return - RiskFreeRate / PeriodsPerYear, 0), 2)))
+//	Sortino = (Mean(Returns) - RiskFreeRate / PeriodsPerYear) / Downside * Sqrt(PeriodsPerYear)
+func Sortino(returns []float64, riskFreeRate float64, periodsPerYear int) float64 {
+	if len(returns) == 0 {
+		return 0
+	}
+
+	target := riskFreeRate / float64(periodsPerYear)
+	sum := 0.0
+
+	for _, r := range returns {
+		shortfall := min(r-target, 0)
+		sum += shortfall * shortfall
+	}
+
+	downside := math.Sqrt(sum / float64(len(returns)))
+	if downside == 0 {
+		return 0
+	}
+
+	return (mean(returns) - target) / downside * math.Sqrt(float64(periodsPerYear))
+}
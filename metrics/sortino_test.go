@@ -0,0 +1,26 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/metrics"
+)
+
+func TestSortino(t *testing.T) {
+	returns := []float64{0.3, -0.1}
+
+	actual := helper.RoundDigit(metrics.Sortino(returns, 0, 4), 2)
+	if actual != 2.83 {
+		t.Fatalf("actual %v expected %v", actual, 2.83)
+	}
+
+	actual = metrics.Sortino([]float64{0.1, 0.2}, 0, 4)
+	if actual != 0 {
+		t.Fatalf("actual %v expected %v", actual, 0)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package metrics
+
+import (
+	"math"
+
+	"github.com/miromax42/indicator/v2/strategy"
+)
+
+// TradeReturns computes the returns of the trades made by following the given
+// actions, the same way as the Outcome function. A Buy enters a long position, a
+// Short enters a short position, each exiting the opposite position first, and a
+// Sell exits the current position. A position that is still open at the end is
+// counted as a trade closed at the last equity.
+//
+//	Trade Return = Exit Equity / Entry Equity - 1
+func TradeReturns(equities []float64, actions []strategy.Action) []float64 {
+	trades := []float64{}
+
+	entry := 0.0
+
+	// No position is held initially.
+	position := strategy.Sell
+
+	n := min(len(equities), len(actions))
+
+	for i := 0; i < n; i++ {
+		if actions[i] == strategy.Hold || actions[i] == position {
+			continue
+		}
+
+		if position != strategy.Sell {
+			trades = append(trades, tradeReturn(entry, equities[i]))
+		}
+
+		entry = equities[i]
+		position = actions[i]
+	}
+
+	if position != strategy.Sell {
+		trades = append(trades, tradeReturn(entry, equities[n-1]))
+	}
+
+	return trades
+}
+
+// TradeStats computes the win rate, the profit factor, the average win, and the
+// average loss of the given trade returns. The profit factor is positive infinity
+// when there are winning trades but no losing trades, and zero when there are
+// neither.
+//
+//	Win Rate = Wins / Trades
+//	Profit Factor = Sum(Wins) / Abs(Sum(Losses))
+func TradeStats(trades []float64) (float64, float64, float64, float64) {
+	if len(trades) == 0 {
+		return 0, 0, 0, 0
+	}
+
+	wins := 0
+	losses := 0
+	profit := 0.0
+	loss := 0.0
+
+	for _, trade := range trades {
+		if trade > 0 {
+			wins++
+			profit += trade
+		} else if trade < 0 {
+			losses++
+			loss -= trade
+		}
+	}
+
+	winRate := float64(wins) / float64(len(trades))
+
+	profitFactor := 0.0
+	if loss > 0 {
+		profitFactor = profit / loss
+	} else if profit > 0 {
+		profitFactor = math.Inf(1)
+	}
+
+	averageWin := 0.0
+	if wins > 0 {
+		averageWin = profit / float64(wins)
+	}
+
+	averageLoss := 0.0
+	if losses > 0 {
+		averageLoss = -loss / float64(losses)
+	}
+
+	return winRate, profitFactor, averageWin, averageLoss
+}
+
+// tradeReturn computes the return of a trade from its entry and exit equities.
+func tradeReturn(entry, exit float64) float64 {
+	if entry <= 0 {
+		return 0
+	}
+
+	return exit/entry - 1
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package metrics_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/metrics"
+	"github.com/miromax42/indicator/v2/strategy"
+)
+
+func TestTradeReturns(t *testing.T) {
+	equities := []float64{1, 1, 1.2, 1.2, 0.9, 1.08}
+	actions := []strategy.Action{
+		strategy.Buy, strategy.Hold, strategy.Sell, strategy.Buy, strategy.Sell, strategy.Buy,
+	}
+
+	expected := helper.SliceToChan([]float64{0.2, -0.25, 0})
+	actual := helper.RoundDigits(helper.SliceToChan(metrics.TradeReturns(equities, actions)), 2)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTradeStats(t *testing.T) {
+	winRate, profitFactor, averageWin, averageLoss := metrics.TradeStats([]float64{0.2, 0.4, -0.2, 0})
+
+	if winRate != 0.5 {
+		t.Fatalf("actual %v expected %v", winRate, 0.5)
+	}
+
+	if helper.RoundDigit(profitFactor, 2) != 3 {
+		t.Fatalf("actual %v expected %v", profitFactor, 3)
+	}
+
+	if helper.RoundDigit(averageWin, 2) != 0.3 {
+		t.Fatalf("actual %v expected %v", averageWin, 0.3)
+	}
+
+	if averageLoss != -0.2 {
+		t.Fatalf("actual %v expected %v", averageLoss, -0.2)
+	}
+}
+
+func TestTradeStatsWithoutLosses(t *testing.T) {
+	_, profitFactor, _, averageLoss := metrics.TradeStats([]float64{0.2})
+
+	if !math.IsInf(profitFactor, 1) || averageLoss != 0 {
+		t.Fatalf("actual %v %v expected %v %v", profitFactor, averageLoss, math.Inf(1), 0)
+	}
+}
+
+func TestTradeStatsWithoutWinsOrLosses(t *testing.T) {
+	_, profitFactor, _, _ := metrics.TradeStats([]float64{0, 0})
+
+	if profitFactor != 0 {
+		t.Fatalf("actual %v expected %v", profitFactor, 0)
+	}
+}
+
+func TestTradeReturnsShort(t *testing.T) {
+	equities := []float64{1, 1.2, 1.5, 1.2}
+	actions := []strategy.Action{
+		strategy.Short, strategy.Buy, strategy.Hold, strategy.Sell,
+	}
+
+	expected := helper.SliceToChan([]float64{0.2, 0})
+	actual := helper.RoundDigits(helper.SliceToChan(metrics.TradeReturns(equities, actions)), 2)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package metrics
+
+import "math"
+
+// Volatility computes the annualized standard deviation of the given returns.
+//
+//	Volatility = Std(Returns) * Sqrt(PeriodsPerYear)
+func Volatility(returns []float64, periodsPerYear int) float64 {
+	return std(returns) * math.Sqrt(float64(periodsPerYear))
+}
+
+// mean computes the mean of the given values.
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sum := 0.0
+
+	for _, value := range values {
+		sum += value
+	}
+
+	return sum / float64(len(values))
+}
+
+// std computes the population standard deviation of the given values.
+func std(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	m := mean(values)
+	sum := 0.0
+
+	for _, value := range values {
+		sum += (value - m) * (value - m)
+	}
+
+	return math.Sqrt(sum / float64(len(values)))
+}
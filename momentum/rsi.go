@@ -5,6 +5,8 @@
 package momentum
 
 import (
+	"math"
+
 	"github.com/miromax42/indicator/v2/helper"
 	"github.com/miromax42/indicator/v2/trend"
 )
@@ -27,6 +29,15 @@ const (
 type Rsi[T helper.Number] struct {
 	// Rma is the RMA instance.
 	Rma *trend.Rma[T]
+
+	// gains is the RMA of the gains for the incremental updates.
+	gains *trend.Rma[T]
+
+	// losses is the RMA of the losses for the incremental updates.
+	losses *trend.Rma[T]
+
+	// before is the previous closing for the incremental updates.
+	before T
 }
 
 // NewRsi function initializes a new Relative Strength Index instance with the default parameters.
@@ -86,6 +97,33 @@ func (r *Rsi[T]) Compute(closings <-chan T) <-chan T {
 	return rsi
 }
 
+// Update takes the next closing and computes the RSI incrementally. It returns
+// false until the RSI yields its first result after the idle period.
+func (r *Rsi[T]) Update(closing T) (T, bool) {
+	if r.gains == nil {
+		r.gains = trend.NewRmaWithPeriod[T](r.Rma.Period)
+		r.losses = trend.NewRmaWithPeriod[T](r.Rma.Period)
+		r.before = closing
+
+		return 0, false
+	}
+
+	change := closing - r.before
+	r.before = closing
+
+	averageGain, _ := r.gains.Update(max(change, 0))
+
+	averageLoss, ok := r.losses.Update(min(change, 0))
+	if !ok {
+		return 0, false
+	}
+
+	rs := averageGain / (averageLoss * -1)
+
+	// RSI = 100 - (100 / (1 + RS))
+	return ((T(math.Pow(float64(rs+1), -1)) * 100) * -1) + 100, true
+}
+
 // IdlePeriod is the initial period that Relative Strength Index won't yield any results.
 func (r *Rsi[T]) IdlePeriod() int {
 	return r.Rma.IdlePeriod() + 1
@@ -37,3 +37,37 @@ func TestRsi(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestRsiUpdate(t *testing.T) {
+	type Data struct {
+		Close float64
+	}
+
+	rows, err := helper.ReadFromCsvFile[Data]("testdata/rsi.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := helper.ChanToSlice(helper.Map(rows, func(d *Data) float64 { return d.Close }))
+
+	rsi := momentum.NewRsi[float64]()
+	expected := rsi.Compute(helper.SliceToChan(input))
+
+	var actual []float64
+
+	for i, n := range input {
+		value, ok := rsi.Update(n)
+		if ok != (i >= rsi.IdlePeriod()) {
+			t.Fatalf("index %d actual %v expected %v", i, ok, !ok)
+		}
+
+		if ok {
+			actual = append(actual, value)
+		}
+	}
+
+	err = helper.CheckEquals(helper.SliceToChan(actual), expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,231 @@
+// Package optimizer contains the strategy parameter optimization functions.
+//
+// This package belongs to the Indicator project. Indicator is
+// a Golang module that supplies a variety of technical
+// indicators, strategies, and a backtesting framework
+// for analysis.
+//
+// # License
+//
+//	Copyright (c) 2021-2024 Onur Cinar.
+//	The source code is provided under GNU AGPLv3 License.
+//	https://github.com/cinar/indicator
+//
+// # Disclaimer
+//
+// The information provided on this project is strictly for
+// informational purposes and is not to be construed as
+// advice or solicitation to buy or sell any security.
+package optimizer
+
+import (
+	"errors"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/backtest"
+	"github.com/miromax42/indicator/v2/metrics"
+	"github.com/miromax42/indicator/v2/strategy"
+)
+
+// Constructor initializes a new strategy instance with the given parameter values,
+// given in the order of the parameters.
+type Constructor func(values []float64) strategy.Strategy
+
+// combinationStrategy wraps the strategy of a combination along with the index of the
+// combination, so that the results can be attributed to the combinations even when the
+// constructor returns the same or a non-comparable strategy for different combinations.
+type combinationStrategy struct {
+	strategy.Strategy
+
+	// index is the index of the combination.
+	index int
+}
+
+// GridSearch backtests a strategy with every combination of the values of its
+// parameters, and ranks the combinations by the objective.
+//
+// Example:
+//
+//	gridSearch := optimizer.NewGridSearch(repository, []*optimizer.Parameter{
+//		optimizer.NewParameter("buyAt", 20, 40, 5),
+//		optimizer.NewParameter("sellAt", 60, 80, 5),
+//	}, func(values []float64) strategy.Strategy {
+//		return momentum.NewRsiStrategyWith(values[0], values[1])
+//	})
+//
+//	results, err := gridSearch.Run()
+type GridSearch struct {
+	// repository is the repository to retrieve the assets from.
+	repository asset.Repository
+
+	// Parameters is the parameters to search.
+	Parameters []*Parameter
+
+	// Constructor initializes the strategy for the parameter values.
+	Constructor Constructor
+
+	// Names is the names of the assets to backtest.
+	Names []string
+
+	// Workers is the number of concurrent backtest workers. The combinations are split
+	// into groups backtested concurrently, each with its share of the workers for the assets.
+	Workers int
+
+	// LastDays is the number of days backtest should go back.
+	LastDays int
+
+	// LastDuration is the duration backtest should go back. It takes precedence
+	// over the last days when it is positive, such as for intraday bars.
+	LastDuration time.Duration
+
+	// LastBars is the number of most recent bars to backtest. It takes precedence over
+	// the last days and the last duration when it is positive. All bars since the
+	// lookback date are used when it is not positive.
+	LastBars int
+
+	// Resampler resamples the snapshots into a coarser timeframe, such as weekly
+	// bars, before the strategies are applied when set.
+	Resampler *asset.Resampler
+
+	// Costs is the transaction costs applied to the outcomes.
+	Costs *strategy.Costs
+
+	// Calculator is the risk and performance metrics calculator.
+	Calculator *metrics.Calculator
+
+	// Objective is the objective the results are ranked by.
+	Objective Objective
+
+	// Logger is the slog logger instance.
+	Logger *slog.Logger
+}
+
+// NewGridSearch function initializes a new grid search instance.
+func NewGridSearch(repository asset.Repository, parameters []*Parameter, constructor Constructor) *GridSearch {
+	return &GridSearch{
+		repository:  repository,
+		Parameters:  parameters,
+		Constructor: constructor,
+		Names:       []string{},
+		Workers:     backtest.DefaultBacktestWorkers,
+		LastDays:    backtest.DefaultLastDays,
+		Costs:       strategy.NewCosts(),
+		Calculator:  metrics.NewCalculator(),
+		Objective:   BySharpe,
+		Logger:      slog.Default(),
+	}
+}
+
+// Run backtests every combination of the parameter values on the assets, and returns
+// the results ranked by the objective. In the absence of explicitly defined assets,
+// encompasses all assets within the repository.
+func (g *GridSearch) Run() ([]*Result, error) {
+	combinations := Combinations(g.Parameters)
+
+	strategies := make([]strategy.Strategy, len(combinations))
+	wrapped := make([]strategy.Strategy, len(combinations))
+
+	for i, values := range combinations {
+		strategies[i] = g.Constructor(values)
+		if strategies[i] == nil {
+			return nil, errors.New("constructor returned no strategy")
+		}
+
+		wrapped[i] = &combinationStrategy{
+			Strategy: strategies[i],
+			index:    i,
+		}
+	}
+
+	// The backtest runs the assets concurrently but the strategies of an asset one after
+	// another, so the combinations are split across the workers as well.
+	groups := min(max(g.Workers, 1), len(wrapped))
+	reports := make([]*backtest.DataReport, groups)
+	errs := make([]error, groups)
+	wg := &sync.WaitGroup{}
+
+	for i := 0; i < groups; i++ {
+		reports[i] = backtest.NewDataReport()
+		reports[i].Calculator = g.Calculator
+
+		backtester := backtest.NewBacktest(g.repository, reports[i])
+		backtester.Names = g.Names
+		backtester.Strategies = wrapped[i*len(wrapped)/groups : (i+1)*len(wrapped)/groups]
+		backtester.Workers = max(g.Workers/groups, 1)
+		backtester.LastDays = g.LastDays
+		backtester.LastDuration = g.LastDuration
+		backtester.LastBars = g.LastBars
+		backtester.Resampler = g.Resampler
+		backtester.Costs = g.Costs
+		backtester.Logger = g.Logger
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = backtester.Run()
+		}(i)
+	}
+
+	wg.Wait()
+
+	err := errors.Join(errs...)
+	if err != nil {
+		return nil, err
+	}
+
+	outcomes := make([]float64, len(combinations))
+	allMetrics := make([][]*metrics.Metrics, len(combinations))
+
+	for _, report := range reports {
+		for _, assetResults := range report.Results {
+			for _, assetResult := range assetResults {
+				combination, ok := assetResult.Strategy.(*combinationStrategy)
+				if !ok {
+					continue
+				}
+
+				i := combination.index
+
+				outcomes[i] += assetResult.Outcome
+				allMetrics[i] = append(allMetrics[i], assetResult.Metrics)
+			}
+		}
+	}
+
+	results := make([]*Result, len(combinations))
+
+	for i, values := range combinations {
+		results[i] = &Result{
+			Values:   values,
+			Strategy: strategies[i],
+			Metrics:  averageMetrics(allMetrics[i]),
+		}
+
+		if len(allMetrics[i]) > 0 {
+			results[i].Outcome = outcomes[i] / float64(len(allMetrics[i]))
+		}
+	}
+
+	// Keep the order of the combinations for the equal scores.
+	slices.SortStableFunc(results, func(a, b *Result) int {
+		scoreA := g.Objective(a)
+		scoreB := g.Objective(b)
+
+		switch {
+		case scoreA > scoreB:
+			return -1
+
+		case scoreA < scoreB:
+			return 1
+
+		default:
+			return 0
+		}
+	})
+
+	return results, nil
+}
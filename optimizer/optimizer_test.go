@@ -0,0 +1,219 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package optimizer_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/optimizer"
+	"github.com/miromax42/indicator/v2/strategy"
+	"github.com/miromax42/indicator/v2/strategy/momentum"
+)
+
+func TestGridSearch(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/repository")
+
+	gridSearch := optimizer.NewGridSearch(repository, []*optimizer.Parameter{
+		optimizer.NewParameter("buyAt", 20, 40, 10),
+		optimizer.NewParameter("sellAt", 60, 80, 10),
+	}, func(values []float64) strategy.Strategy {
+		return momentum.NewRsiStrategyWith(values[0], values[1])
+	})
+	gridSearch.LastDays = 10000
+	gridSearch.Objective = optimizer.ByOutcome
+
+	results, err := gridSearch.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != 9 {
+		t.Fatalf("actual %v expected %v", len(results), 9)
+	}
+
+	for i, result := range results {
+		if len(result.Values) != 2 || result.Metrics == nil {
+			t.Fatalf("invalid result %v", result)
+		}
+
+		if i > 0 && results[i-1].Outcome < result.Outcome {
+			t.Fatalf("actual %v expected at most %v", result.Outcome, results[i-1].Outcome)
+		}
+	}
+}
+
+func TestGridSearchMissingAsset(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/repository")
+
+	gridSearch := optimizer.NewGridSearch(repository, []*optimizer.Parameter{
+		optimizer.NewParameter("buyAt", 30, 30, 1),
+	}, func(values []float64) strategy.Strategy {
+		return momentum.NewRsiStrategyWith(values[0], 70)
+	})
+	gridSearch.Names = []string{"unknown"}
+
+	results, err := gridSearch.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != 1 || results[0].Outcome != 0 || results[0].Metrics.Trades != 0 {
+		t.Fatalf("invalid result %v", results)
+	}
+}
+
+func TestGridSearchNoStrategy(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/repository")
+
+	gridSearch := optimizer.NewGridSearch(repository, []*optimizer.Parameter{
+		optimizer.NewParameter("period", 1, 2, 1),
+	}, func(_ []float64) strategy.Strategy {
+		return nil
+	})
+
+	_, err := gridSearch.Run()
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestGridSearchSharedStrategy(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/repository")
+	shared := momentum.NewRsiStrategy()
+
+	gridSearch := optimizer.NewGridSearch(repository, []*optimizer.Parameter{
+		optimizer.NewParameter("period", 1, 3, 1),
+	}, func(_ []float64) strategy.Strategy {
+		return shared
+	})
+	gridSearch.LastDays = 10000
+
+	results, err := gridSearch.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, result := range results {
+		if result.Outcome != results[0].Outcome || result.Metrics.Trades == 0 {
+			t.Fatalf("invalid result %v", result)
+		}
+	}
+}
+
+// uncomparableStrategy is a strategy value that can't be used as a map key.
+type uncomparableStrategy struct {
+	strategy.Strategy
+
+	values []float64
+}
+
+func TestGridSearchUncomparableStrategy(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/repository")
+	parameters := []*optimizer.Parameter{
+		optimizer.NewParameter("buyAt", 20, 40, 10),
+	}
+
+	gridSearch := optimizer.NewGridSearch(repository, parameters, func(values []float64) strategy.Strategy {
+		return momentum.NewRsiStrategyWith(values[0], 70)
+	})
+	gridSearch.LastDays = 10000
+
+	expected, err := gridSearch.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gridSearch = optimizer.NewGridSearch(repository, parameters, func(values []float64) strategy.Strategy {
+		return uncomparableStrategy{
+			Strategy: momentum.NewRsiStrategyWith(values[0], 70),
+			values:   values,
+		}
+	})
+	gridSearch.LastDays = 10000
+
+	actual, err := gridSearch.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range expected {
+		if actual[i].Values[0] != expected[i].Values[0] || actual[i].Outcome != expected[i].Outcome {
+			t.Fatalf("actual %v expected %v", actual[i], expected[i])
+		}
+	}
+}
+
+// barrierStrategy is a strategy that waits for the given number of strategies to be
+// computed concurrently before computing its actions.
+type barrierStrategy struct {
+	strategy.Strategy
+
+	barrier *barrier
+}
+
+func (b *barrierStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	b.barrier.Wait()
+	return b.Strategy.Compute(snapshots)
+}
+
+// barrier is released once the expected number of callers wait on it at the same time.
+type barrier struct {
+	mu       sync.Mutex
+	waiting  int
+	expected int
+	released chan struct{}
+}
+
+func (b *barrier) Wait() {
+	b.mu.Lock()
+	b.waiting++
+	if b.waiting == b.expected {
+		close(b.released)
+	}
+	b.mu.Unlock()
+
+	select {
+	case <-b.released:
+	case <-time.After(time.Second):
+		b.mu.Lock()
+		b.waiting--
+		b.mu.Unlock()
+	}
+}
+
+func TestGridSearchConcurrentCombinations(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/repository")
+	parameters := []*optimizer.Parameter{
+		optimizer.NewParameter("buyAt", 20, 50, 10),
+	}
+
+	b := &barrier{
+		expected: 4,
+		released: make(chan struct{}),
+	}
+
+	gridSearch := optimizer.NewGridSearch(repository, parameters, func(values []float64) strategy.Strategy {
+		return &barrierStrategy{
+			Strategy: momentum.NewRsiStrategyWith(values[0], 70),
+			barrier:  b,
+		}
+	})
+	gridSearch.Names = []string{"brk-b"}
+	gridSearch.Workers = 4
+
+	_, err := gridSearch.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-b.released:
+	default:
+		t.Fatal("expected the combinations to be backtested concurrently")
+	}
+}
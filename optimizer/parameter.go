@@ -0,0 +1,81 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package optimizer
+
+import (
+	"fmt"
+	"math"
+)
+
+// Parameter is a numeric strategy parameter with the range of values to search.
+type Parameter struct {
+	// Name is the name of the parameter.
+	Name string
+
+	// Min is the first value of the range.
+	Min float64
+
+	// Max is the last value of the range, inclusive.
+	Max float64
+
+	// Step is the increment between the values.
+	Step float64
+}
+
+// NewParameter function initializes a new parameter instance with the given range.
+func NewParameter(name string, min, max, step float64) *Parameter {
+	return &Parameter{
+		Name: name,
+		Min:  min,
+		Max:  max,
+		Step: step,
+	}
+}
+
+// Values returns the values in the range of the parameter. A non-positive step
+// results in only the first value.
+func (p *Parameter) Values() []float64 {
+	if p.Step <= 0 || p.Max < p.Min {
+		return []float64{p.Min}
+	}
+
+	// Counting the steps avoids accumulating the floating point errors.
+	count := int(math.Floor((p.Max-p.Min)/p.Step+1e-9)) + 1
+	values := make([]float64, count)
+
+	for i := range values {
+		values[i] = p.Min + float64(i)*p.Step
+	}
+
+	return values
+}
+
+// String is the string representation of the parameter.
+func (p *Parameter) String() string {
+	return fmt.Sprintf("%s=%g:%g:%g", p.Name, p.Min, p.Max, p.Step)
+}
+
+// Combinations returns the cartesian product of the values of the given parameters,
+// with the values of each combination in the order of the parameters.
+func Combinations(parameters []*Parameter) [][]float64 {
+	combinations := [][]float64{{}}
+
+	for _, parameter := range parameters {
+		values := parameter.Values()
+		next := make([][]float64, 0, len(combinations)*len(values))
+
+		for _, combination := range combinations {
+			for _, value := range values {
+				extended := make([]float64, len(combination), len(combination)+1)
+				copy(extended, combination)
+				next = append(next, append(extended, value))
+			}
+		}
+
+		combinations = next
+	}
+
+	return combinations
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package optimizer_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/miromax42/indicator/v2/optimizer"
+)
+
+func TestParameterValues(t *testing.T) {
+	parameter := optimizer.NewParameter("period", 0.1, 0.5, 0.1)
+
+	actual := parameter.Values()
+	expected := []float64{0.1, 0.2, 0.30000000000000004, 0.4, 0.5}
+
+	if !slices.Equal(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestParameterValuesWithoutStep(t *testing.T) {
+	parameter := optimizer.NewParameter("period", 10, 20, 0)
+
+	actual := parameter.Values()
+	expected := []float64{10}
+
+	if !slices.Equal(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestParameterString(t *testing.T) {
+	parameter := optimizer.NewParameter("period", 10, 20, 5)
+
+	actual := parameter.String()
+	expected := "period=10:20:5"
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	combinations := optimizer.Combinations([]*optimizer.Parameter{
+		optimizer.NewParameter("a", 1, 2, 1),
+		optimizer.NewParameter("b", 10, 30, 10),
+	})
+
+	expected := [][]float64{
+		{1, 10}, {1, 20}, {1, 30},
+		{2, 10}, {2, 20}, {2, 30},
+	}
+
+	if len(combinations) != len(expected) {
+		t.Fatalf("actual %v expected %v", len(combinations), len(expected))
+	}
+
+	for i, combination := range combinations {
+		if !slices.Equal(combination, expected[i]) {
+			t.Fatalf("actual %v expected %v", combination, expected[i])
+		}
+	}
+}
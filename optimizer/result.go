@@ -0,0 +1,106 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package optimizer
+
+import (
+	"math"
+
+	"github.com/miromax42/indicator/v2/metrics"
+	"github.com/miromax42/indicator/v2/strategy"
+)
+
+// Result is the result of backtesting a single combination of the parameter values.
+type Result struct {
+	// Values is the parameter values in the order of the parameters.
+	Values []float64
+
+	// Strategy is the strategy initialized with the parameter values.
+	Strategy strategy.Strategy
+
+	// Outcome is the average outcome percent across the assets.
+	Outcome float64
+
+	// Metrics is the metrics averaged across the assets. The number of trades is
+	// the total, and the maximum drawdown duration is the longest across the assets.
+	// The profit factor is averaged across the assets with a finite profit factor, and
+	// it is infinite only when all assets have winning trades but no losing trades.
+	Metrics *metrics.Metrics
+}
+
+// Objective returns the score of a result. The results with the higher scores rank first.
+type Objective func(result *Result) float64
+
+// ByOutcome scores the results by their outcomes.
+func ByOutcome(result *Result) float64 {
+	return result.Outcome
+}
+
+// BySharpe scores the results by their Sharpe ratios.
+func BySharpe(result *Result) float64 {
+	return result.Metrics.Sharpe
+}
+
+// BySortino scores the results by their Sortino ratios.
+func BySortino(result *Result) float64 {
+	return result.Metrics.Sortino
+}
+
+// ByCalmar scores the results by their Calmar ratios.
+func ByCalmar(result *Result) float64 {
+	return result.Metrics.Calmar
+}
+
+// averageMetrics averages the given metrics. The infinite profit factors of the metrics
+// without losing trades are skipped, as they would make the average infinite.
+func averageMetrics(all []*metrics.Metrics) *metrics.Metrics {
+	average := &metrics.Metrics{}
+
+	if len(all) == 0 {
+		return average
+	}
+
+	profitFactors := 0
+
+	for _, m := range all {
+		average.Cagr += m.Cagr
+		average.Volatility += m.Volatility
+		average.Sharpe += m.Sharpe
+		average.Sortino += m.Sortino
+		average.Calmar += m.Calmar
+		average.MaxDrawdown += m.MaxDrawdown
+		average.MaxDrawdownDuration = max(average.MaxDrawdownDuration, m.MaxDrawdownDuration)
+		average.WinRate += m.WinRate
+		average.AverageWin += m.AverageWin
+		average.AverageLoss += m.AverageLoss
+		average.Exposure += m.Exposure
+		average.Trades += m.Trades
+
+		if !math.IsInf(m.ProfitFactor, 1) {
+			average.ProfitFactor += m.ProfitFactor
+			profitFactors++
+		}
+	}
+
+	n := float64(len(all))
+
+	average.Cagr /= n
+	average.Volatility /= n
+	average.Sharpe /= n
+	average.Sortino /= n
+	average.Calmar /= n
+	average.MaxDrawdown /= n
+	average.WinRate /= n
+	average.AverageWin /= n
+	average.AverageLoss /= n
+	average.Exposure /= n
+
+	if profitFactors > 0 {
+		average.ProfitFactor /= float64(profitFactors)
+	} else {
+		average.ProfitFactor = math.Inf(1)
+	}
+
+	return average
+}
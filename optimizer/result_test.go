@@ -0,0 +1,37 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package optimizer
+
+import (
+	"math"
+	"testing"
+
+	"github.com/miromax42/indicator/v2/metrics"
+)
+
+func TestAverageMetricsInfiniteProfitFactor(t *testing.T) {
+	tests := []struct {
+		profitFactors []float64
+		expected      float64
+	}{
+		{profitFactors: []float64{2, math.Inf(1), 4}, expected: 3},
+		{profitFactors: []float64{math.Inf(1), math.Inf(1)}, expected: math.Inf(1)},
+		{profitFactors: []float64{0, 1}, expected: 0.5},
+	}
+
+	for _, test := range tests {
+		all := make([]*metrics.Metrics, len(test.profitFactors))
+		for i, profitFactor := range test.profitFactors {
+			all[i] = &metrics.Metrics{
+				ProfitFactor: profitFactor,
+			}
+		}
+
+		actual := averageMetrics(all).ProfitFactor
+		if actual != test.expected {
+			t.Fatalf("actual %v expected %v", actual, test.expected)
+		}
+	}
+}
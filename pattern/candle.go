@@ -0,0 +1,99 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package pattern
+
+import (
+	"math"
+	"slices"
+
+	"github.com/miromax42/indicator/v2/helper"
+)
+
+// candle is a single candlestick.
+type candle struct {
+	open  float64
+	high  float64
+	low   float64
+	close float64
+}
+
+// body returns the length of the candle body.
+func (c *candle) body() float64 {
+	return math.Abs(c.close - c.open)
+}
+
+// length returns the length of the candle from its low to its high.
+func (c *candle) length() float64 {
+	return c.high - c.low
+}
+
+// bodyHigh returns the top of the candle body.
+func (c *candle) bodyHigh() float64 {
+	return math.Max(c.open, c.close)
+}
+
+// bodyLow returns the bottom of the candle body.
+func (c *candle) bodyLow() float64 {
+	return math.Min(c.open, c.close)
+}
+
+// bodyMiddle returns the middle of the candle body.
+func (c *candle) bodyMiddle() float64 {
+	return (c.open + c.close) / 2
+}
+
+// upperShadow returns the length of the upper shadow of the candle.
+func (c *candle) upperShadow() float64 {
+	return c.high - c.bodyHigh()
+}
+
+// lowerShadow returns the length of the lower shadow of the candle.
+func (c *candle) lowerShadow() float64 {
+	return c.bodyLow() - c.low
+}
+
+// isBullish returns true if the candle closed above its opening.
+func (c *candle) isBullish() bool {
+	return c.close > c.open
+}
+
+// isBearish returns true if the candle closed below its opening.
+func (c *candle) isBearish() bool {
+	return c.close < c.open
+}
+
+// candles combines the given openings, highs, lows, and closings into a stream of candles.
+func candles[T helper.Number](openings, highs, lows, closings <-chan T) <-chan *candle {
+	partials := helper.Operate3(highs, lows, closings, func(high, low, closing T) *candle {
+		return &candle{
+			high:  float64(high),
+			low:   float64(low),
+			close: float64(closing),
+		}
+	})
+
+	return helper.Operate(openings, partials, func(opening T, c *candle) *candle {
+		c.open = float64(opening)
+		return c
+	})
+}
+
+// candleWindows returns a stream of the given number of consecutive candles, with
+// the most recent candle being the last one. The first window is yielded once
+// enough candles are received.
+func candleWindows(c <-chan *candle, size int) <-chan []*candle {
+	window := make([]*candle, 0, size+1)
+
+	windows := helper.Map(c, func(c *candle) []*candle {
+		window = append(window, c)
+		if len(window) > size {
+			window = window[1:]
+		}
+
+		return slices.Clone(window)
+	})
+
+	return helper.Skip(windows, size-1)
+}
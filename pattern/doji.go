@@ -0,0 +1,60 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package pattern
+
+import "github.com/miromax42/indicator/v2/helper"
+
+const (
+	// DefaultDojiMaxBodyRatio is the default maximum ratio of the body to the length of the candle for the Doji.
+	DefaultDojiMaxBodyRatio = 0.1
+)
+
+// Doji represents the configuration parameters for detecting the Doji pattern. A Doji is
+// a candle whose opening and closing are virtually equal, suggesting indecision between
+// the buyers and the sellers. It generates a Neutral signal.
+//
+//	Body <= Max Body Ratio * (High - Low)
+//
+// Example:
+//
+//	doji := pattern.NewDoji[float64]()
+//	signals := doji.Compute(openings, highs, lows, closings)
+type Doji[T helper.Number] struct {
+	// MaxBodyRatio is the maximum ratio of the body to the length of the candle.
+	MaxBodyRatio float64
+}
+
+// NewDoji function initializes a new Doji instance with the default parameters.
+func NewDoji[T helper.Number]() *Doji[T] {
+	return NewDojiWith[T](DefaultDojiMaxBodyRatio)
+}
+
+// NewDojiWith function initializes a new Doji instance with the given maximum body ratio.
+func NewDojiWith[T helper.Number](maxBodyRatio float64) *Doji[T] {
+	return &Doji[T]{
+		MaxBodyRatio: maxBodyRatio,
+	}
+}
+
+// Name returns the name of the pattern.
+func (*Doji[T]) Name() string {
+	return "Doji"
+}
+
+// Compute function takes channels of openings, highs, lows, and closings, and detects the Doji pattern.
+func (d *Doji[T]) Compute(openings, highs, lows, closings <-chan T) <-chan Signal {
+	return helper.Map(candles(openings, highs, lows, closings), func(c *candle) Signal {
+		if c.length() > 0 && c.body() <= d.MaxBodyRatio*c.length() {
+			return Neutral
+		}
+
+		return None
+	})
+}
+
+// IdlePeriod is the initial period that Doji won't yield any results.
+func (*Doji[T]) IdlePeriod() int {
+	return 0
+}
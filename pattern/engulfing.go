@@ -0,0 +1,60 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package pattern
+
+import "github.com/miromax42/indicator/v2/helper"
+
+// Engulfing represents the configuration parameters for detecting the Engulfing pattern. An
+// Engulfing is a candle whose body completely engulfs the body of the previous candle in the
+// opposite direction.
+//
+// A bullish candle engulfing a bearish candle generates a Bullish signal, while a bearish
+// candle engulfing a bullish candle generates a Bearish signal.
+//
+// Example:
+//
+//	engulfing := pattern.NewEngulfing[float64]()
+//	signals := engulfing.Compute(openings, highs, lows, closings)
+type Engulfing[T helper.Number] struct{}
+
+// NewEngulfing function initializes a new Engulfing instance.
+func NewEngulfing[T helper.Number]() *Engulfing[T] {
+	return &Engulfing[T]{}
+}
+
+// Name returns the name of the pattern.
+func (*Engulfing[T]) Name() string {
+	return "Engulfing"
+}
+
+// Compute function takes channels of openings, highs, lows, and closings, and detects the Engulfing pattern.
+func (e *Engulfing[T]) Compute(openings, highs, lows, closings <-chan T) <-chan Signal {
+	windows := candleWindows(candles(openings, highs, lows, closings), e.IdlePeriod()+1)
+
+	return helper.Map(windows, func(w []*candle) Signal {
+		previous, current := w[0], w[1]
+
+		if current.body() <= previous.body() ||
+			current.bodyHigh() < previous.bodyHigh() ||
+			current.bodyLow() > previous.bodyLow() {
+			return None
+		}
+
+		if previous.isBearish() && current.isBullish() {
+			return Bullish
+		}
+
+		if previous.isBullish() && current.isBearish() {
+			return Bearish
+		}
+
+		return None
+	})
+}
+
+// IdlePeriod is the initial period that Engulfing won't yield any results.
+func (*Engulfing[T]) IdlePeriod() int {
+	return 1
+}
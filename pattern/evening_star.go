@@ -0,0 +1,71 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package pattern
+
+import "github.com/miromax42/indicator/v2/helper"
+
+const (
+	// DefaultEveningStarBodyRatio is the default maximum ratio of the star body to the first body for the Evening Star.
+	DefaultEveningStarBodyRatio = 0.3
+)
+
+// EveningStar represents the configuration parameters for detecting the Evening Star pattern.
+// An Evening Star is a three candle top reversal pattern. A bullish candle is followed by a
+// candle with a small body gapping above the first body, the star, and then by a bearish candle
+// closing below the middle of the first body. It generates a Bearish signal.
+//
+//	Star Body <= Body Ratio * First Body
+//	Star Body Low > First Close
+//	Third Close < (First Open + First Close) / 2
+//
+// Example:
+//
+//	eveningStar := pattern.NewEveningStar[float64]()
+//	signals := eveningStar.Compute(openings, highs, lows, closings)
+type EveningStar[T helper.Number] struct {
+	// BodyRatio is the maximum ratio of the star body to the first body.
+	BodyRatio float64
+}
+
+// NewEveningStar function initializes a new Evening Star instance with the default parameters.
+func NewEveningStar[T helper.Number]() *EveningStar[T] {
+	return NewEveningStarWith[T](DefaultEveningStarBodyRatio)
+}
+
+// NewEveningStarWith function initializes a new Evening Star instance with the given body ratio.
+func NewEveningStarWith[T helper.Number](bodyRatio float64) *EveningStar[T] {
+	return &EveningStar[T]{
+		BodyRatio: bodyRatio,
+	}
+}
+
+// Name returns the name of the pattern.
+func (*EveningStar[T]) Name() string {
+	return "Evening Star"
+}
+
+// Compute function takes channels of openings, highs, lows, and closings, and detects the Evening Star pattern.
+func (e *EveningStar[T]) Compute(openings, highs, lows, closings <-chan T) <-chan Signal {
+	windows := candleWindows(candles(openings, highs, lows, closings), e.IdlePeriod()+1)
+
+	return helper.Map(windows, func(w []*candle) Signal {
+		first, star, third := w[0], w[1], w[2]
+
+		if first.isBullish() &&
+			star.body() <= e.BodyRatio*first.body() &&
+			star.bodyLow() > first.bodyHigh() &&
+			third.isBearish() &&
+			third.close < first.bodyMiddle() {
+			return Bearish
+		}
+
+		return None
+	})
+}
+
+// IdlePeriod is the initial period that Evening Star won't yield any results.
+func (*EveningStar[T]) IdlePeriod() int {
+	return 2
+}
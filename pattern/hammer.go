@@ -0,0 +1,81 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package pattern
+
+import (
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/trend"
+)
+
+const (
+	// DefaultHammerShadowRatio is the default minimum ratio of the lower shadow to the body for the Hammer.
+	DefaultHammerShadowRatio = 2
+
+	// DefaultHammerTrendPeriod is the default period of the SMA defining the downtrend for the Hammer.
+	DefaultHammerTrendPeriod = 10
+)
+
+// Hammer represents the configuration parameters for detecting the Hammer pattern. A Hammer
+// is a candle with a small body at the top of its range and a long lower shadow in a downtrend,
+// suggesting that the sellers were rejected. It generates a Bullish signal. The same candle in
+// an uptrend is a Hanging Man, which is not detected.
+//
+//	Lower Shadow >= Shadow Ratio * Body
+//	Upper Shadow <= Body
+//	Close < SMA(Trend Period, Closings)
+//
+// Example:
+//
+//	hammer := pattern.NewHammer[float64]()
+//	signals := hammer.Compute(openings, highs, lows, closings)
+type Hammer[T helper.Number] struct {
+	// ShadowRatio is the minimum ratio of the lower shadow to the body.
+	ShadowRatio float64
+
+	// Trend is the SMA instance defining the downtrend.
+	Trend *trend.Sma[T]
+}
+
+// NewHammer function initializes a new Hammer instance with the default parameters.
+func NewHammer[T helper.Number]() *Hammer[T] {
+	return NewHammerWith[T](DefaultHammerShadowRatio, DefaultHammerTrendPeriod)
+}
+
+// NewHammerWith function initializes a new Hammer instance with the given shadow ratio and trend period.
+func NewHammerWith[T helper.Number](shadowRatio float64, trendPeriod int) *Hammer[T] {
+	return &Hammer[T]{
+		ShadowRatio: shadowRatio,
+		Trend:       trend.NewSmaWithPeriod[T](trendPeriod),
+	}
+}
+
+// Name returns the name of the pattern.
+func (*Hammer[T]) Name() string {
+	return "Hammer"
+}
+
+// Compute function takes channels of openings, highs, lows, and closings, and detects the Hammer pattern.
+func (h *Hammer[T]) Compute(openings, highs, lows, closings <-chan T) <-chan Signal {
+	closingsSplice := helper.Duplicate(closings, 2)
+
+	smas := h.Trend.Compute(closingsSplice[0])
+	cs := helper.Skip(candles(openings, highs, lows, closingsSplice[1]), h.Trend.IdlePeriod())
+
+	return helper.Operate(cs, smas, func(c *candle, sma T) Signal {
+		if c.close < float64(sma) &&
+			c.lowerShadow() > 0 &&
+			c.lowerShadow() >= h.ShadowRatio*c.body() &&
+			c.upperShadow() <= c.body() {
+			return Bullish
+		}
+
+		return None
+	})
+}
+
+// IdlePeriod is the initial period that Hammer won't yield any results.
+func (h *Hammer[T]) IdlePeriod() int {
+	return h.Trend.IdlePeriod()
+}
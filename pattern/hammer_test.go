@@ -0,0 +1,44 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package pattern_test
+
+import (
+	"testing"
+
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/pattern"
+)
+
+func TestHammer(t *testing.T) {
+	type Data struct {
+		Open   float64
+		High   float64
+		Low    float64
+		Close  float64
+		Signal pattern.Signal
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/hammer.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 5)
+	openings := helper.Map(inputs[0], func(d *Data) float64 { return d.Open })
+	highs := helper.Map(inputs[1], func(d *Data) float64 { return d.High })
+	lows := helper.Map(inputs[2], func(d *Data) float64 { return d.Low })
+	closings := helper.Map(inputs[3], func(d *Data) float64 { return d.Close })
+	expected := helper.Map(inputs[4], func(d *Data) pattern.Signal { return d.Signal })
+
+	hammer := pattern.NewHammer[float64]()
+	actual := hammer.Compute(openings, highs, lows, closings)
+
+	expected = helper.Skip(expected, hammer.IdlePeriod())
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package pattern
+
+import "github.com/miromax42/indicator/v2/helper"
+
+// Harami represents the configuration parameters for detecting the Harami pattern. A Harami
+// is a candle whose body is completely contained within the body of the previous candle in
+// the opposite direction.
+//
+// A bullish candle within a bearish candle generates a Bullish signal, while a bearish
+// candle within a bullish candle generates a Bearish signal.
+//
+// Example:
+//
+//	harami := pattern.NewHarami[float64]()
+//	signals := harami.Compute(openings, highs, lows, closings)
+type Harami[T helper.Number] struct{}
+
+// NewHarami function initializes a new Harami instance.
+func NewHarami[T helper.Number]() *Harami[T] {
+	return &Harami[T]{}
+}
+
+// Name returns the name of the pattern.
+func (*Harami[T]) Name() string {
+	return "Harami"
+}
+
+// Compute function takes channels of openings, highs, lows, and closings, and detects the Harami pattern.
+func (h *Harami[T]) Compute(openings, highs, lows, closings <-chan T) <-chan Signal {
+	windows := candleWindows(candles(openings, highs, lows, closings), h.IdlePeriod()+1)
+
+	return helper.Map(windows, func(w []*candle) Signal {
+		previous, current := w[0], w[1]
+
+		if current.body() >= previous.body() ||
+			current.bodyHigh() > previous.bodyHigh() ||
+			current.bodyLow() < previous.bodyLow() {
+			return None
+		}
+
+		if previous.isBearish() && current.isBullish() {
+			return Bullish
+		}
+
+		if previous.isBullish() && current.isBearish() {
+			return Bearish
+		}
+
+		return None
+	})
+}
+
+// IdlePeriod is the initial period that Harami won't yield any results.
+func (*Harami[T]) IdlePeriod() int {
+	return 1
+}
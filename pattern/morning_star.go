@@ -0,0 +1,71 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package pattern
+
+import "github.com/miromax42/indicator/v2/helper"
+
+const (
+	// DefaultMorningStarBodyRatio is the default maximum ratio of the star body to the first body for the Morning Star.
+	DefaultMorningStarBodyRatio = 0.3
+)
+
+// MorningStar represents the configuration parameters for detecting the Morning Star pattern.
+// A Morning Star is a three candle bottom reversal pattern. A bearish candle is followed by a
+// candle with a small body gapping below the first body, the star, and then by a bullish candle
+// closing above the middle of the first body. It generates a Bullish signal.
+//
+//	Star Body <= Body Ratio * First Body
+//	Star Body High < First Close
+//	Third Close > (First Open + First Close) / 2
+//
+// Example:
+//
+//	morningStar := pattern.NewMorningStar[float64]()
+//	signals := morningStar.Compute(openings, highs, lows, closings)
+type MorningStar[T helper.Number] struct {
+	// BodyRatio is the maximum ratio of the star body to the first body.
+	BodyRatio float64
+}
+
+// NewMorningStar function initializes a new Morning Star instance with the default parameters.
+func NewMorningStar[T helper.Number]() *MorningStar[T] {
+	return NewMorningStarWith[T](DefaultMorningStarBodyRatio)
+}
+
+// NewMorningStarWith function initializes a new Morning Star instance with the given body ratio.
+func NewMorningStarWith[T helper.Number](bodyRatio float64) *MorningStar[T] {
+	return &MorningStar[T]{
+		BodyRatio: bodyRatio,
+	}
+}
+
+// Name returns the name of the pattern.
+func (*MorningStar[T]) Name() string {
+	return "Morning Star"
+}
+
+// Compute function takes channels of openings, highs, lows, and closings, and detects the Morning Star pattern.
+func (m *MorningStar[T]) Compute(openings, highs, lows, closings <-chan T) <-chan Signal {
+	windows := candleWindows(candles(openings, highs, lows, closings), m.IdlePeriod()+1)
+
+	return helper.Map(windows, func(w []*candle) Signal {
+		first, star, third := w[0], w[1], w[2]
+
+		if first.isBearish() &&
+			star.body() <= m.BodyRatio*first.body() &&
+			star.bodyHigh() < first.bodyLow() &&
+			third.isBullish() &&
+			third.close > first.bodyMiddle() {
+			return Bullish
+		}
+
+		return None
+	})
+}
+
+// IdlePeriod is the initial period that Morning Star won't yield any results.
+func (*MorningStar[T]) IdlePeriod() int {
+	return 2
+}
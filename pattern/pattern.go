@@ -0,0 +1,49 @@
+// Package pattern contains the candlestick pattern functions.
+//
+// This package belongs to the Indicator project. Indicator is
+// a Golang module that supplies a variety of technical
+// indicators, strategies, and a backtesting framework
+// for analysis.
+//
+// # License
+//
+//	Copyright (c) 2021-2024 Onur Cinar.
+//	The source code is provided under GNU AGPLv3 License.
+//	https://github.com/cinar/indicator
+//
+// # Disclaimer
+//
+// The information provided on this project is strictly for
+// informational purposes and is not to be construed as
+// advice or solicitation to buy or sell any security.
+package pattern
+
+import "github.com/miromax42/indicator/v2/helper"
+
+// Pattern defines the interface that all candlestick patterns must implement.
+type Pattern[T helper.Number] interface {
+	// Name returns the name of the pattern.
+	Name() string
+
+	// Compute processes the provided openings, highs, lows, and closings, and
+	// generates a stream of signals for the detected pattern.
+	Compute(openings, highs, lows, closings <-chan T) <-chan Signal
+
+	// IdlePeriod is the initial period that the pattern won't yield any results.
+	IdlePeriod() int
+}
+
+// AllPatterns returns a slice containing references to all available candlestick patterns.
+func AllPatterns[T helper.Number]() []Pattern[T] {
+	return []Pattern[T]{
+		NewDoji[T](),
+		NewEngulfing[T](),
+		NewEveningStar[T](),
+		NewHammer[T](),
+		NewHarami[T](),
+		NewMorningStar[T](),
+		NewShootingStar[T](),
+		NewThreeBlackCrows[T](),
+		NewThreeWhiteSoldiers[T](),
+	}
+}
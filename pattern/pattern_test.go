@@ -0,0 +1,125 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package pattern_test
+
+import (
+	"testing"
+
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/pattern"
+)
+
+// patternData is a candle along with the expected signal.
+type patternData struct {
+	Open   float64
+	High   float64
+	Low    float64
+	Close  float64
+	Signal pattern.Signal
+}
+
+// computePattern computes the signals of the given pattern for the given candles, and
+// returns them along with the expected signals, both after the idle period.
+func computePattern(p pattern.Pattern[float64], input <-chan *patternData) (<-chan pattern.Signal, <-chan pattern.Signal) {
+	inputs := helper.Duplicate(input, 5)
+	openings := helper.Map(inputs[0], func(d *patternData) float64 { return d.Open })
+	highs := helper.Map(inputs[1], func(d *patternData) float64 { return d.High })
+	lows := helper.Map(inputs[2], func(d *patternData) float64 { return d.Low })
+	closings := helper.Map(inputs[3], func(d *patternData) float64 { return d.Close })
+	expected := helper.Map(inputs[4], func(d *patternData) pattern.Signal { return d.Signal })
+
+	actual := p.Compute(openings, highs, lows, closings)
+	expected = helper.Skip(expected, p.IdlePeriod())
+
+	return actual, expected
+}
+
+func TestPatternsContext(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern pattern.Pattern[float64]
+		input   []*patternData
+	}{
+		{
+			name:    "Hammer in a downtrend",
+			pattern: pattern.NewHammerWith[float64](2, 2),
+			input: []*patternData{
+				{Open: 12, High: 12, Low: 11, Close: 11},
+				{Open: 10, High: 10.2, Low: 8, Close: 10.1, Signal: pattern.Bullish},
+			},
+		},
+		{
+			name:    "Hanging Man in an uptrend",
+			pattern: pattern.NewHammerWith[float64](2, 2),
+			input: []*patternData{
+				{Open: 8, High: 9, Low: 8, Close: 9},
+				{Open: 10, High: 10.2, Low: 8, Close: 10.1},
+			},
+		},
+		{
+			name:    "Shooting Star in an uptrend",
+			pattern: pattern.NewShootingStarWith[float64](2, 2),
+			input: []*patternData{
+				{Open: 8, High: 9, Low: 8, Close: 9},
+				{Open: 10.1, High: 12, Low: 10, Close: 10, Signal: pattern.Bearish},
+			},
+		},
+		{
+			name:    "Inverted Hammer in a downtrend",
+			pattern: pattern.NewShootingStarWith[float64](2, 2),
+			input: []*patternData{
+				{Open: 12, High: 12, Low: 11, Close: 11},
+				{Open: 10.1, High: 12, Low: 10, Close: 10},
+			},
+		},
+		{
+			name:    "Morning Star with a gap",
+			pattern: pattern.NewMorningStar[float64](),
+			input: []*patternData{
+				{Open: 20, High: 20, Low: 15, Close: 15},
+				{Open: 14, High: 14.5, Low: 13, Close: 13.5},
+				{Open: 15, High: 19, Low: 15, Close: 19, Signal: pattern.Bullish},
+			},
+		},
+		{
+			name:    "Morning Star without a gap",
+			pattern: pattern.NewMorningStar[float64](),
+			input: []*patternData{
+				{Open: 20, High: 20, Low: 15, Close: 15},
+				{Open: 15.5, High: 16, Low: 15, Close: 15.2},
+				{Open: 15, High: 19, Low: 15, Close: 19},
+			},
+		},
+		{
+			name:    "Evening Star with a gap",
+			pattern: pattern.NewEveningStar[float64](),
+			input: []*patternData{
+				{Open: 15, High: 20, Low: 15, Close: 20},
+				{Open: 21, High: 22, Low: 20.5, Close: 21.5},
+				{Open: 20, High: 20, Low: 16, Close: 16, Signal: pattern.Bearish},
+			},
+		},
+		{
+			name:    "Evening Star without a gap",
+			pattern: pattern.NewEveningStar[float64](),
+			input: []*patternData{
+				{Open: 15, High: 20, Low: 15, Close: 20},
+				{Open: 19.5, High: 20, Low: 19, Close: 19.8},
+				{Open: 20, High: 20, Low: 16, Close: 16},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, expected := computePattern(test.pattern, helper.SliceToChan(test.input))
+
+			err := helper.CheckEquals(actual, expected)
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
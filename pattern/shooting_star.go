@@ -0,0 +1,82 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package pattern
+
+import (
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/trend"
+)
+
+const (
+	// DefaultShootingStarShadowRatio is the default minimum ratio of the upper shadow to the body for the Shooting Star.
+	DefaultShootingStarShadowRatio = 2
+
+	// DefaultShootingStarTrendPeriod is the default period of the SMA defining the uptrend for the Shooting Star.
+	DefaultShootingStarTrendPeriod = 10
+)
+
+// ShootingStar represents the configuration parameters for detecting the Shooting Star pattern.
+// A Shooting Star is a candle with a small body at the bottom of its range and a long upper
+// shadow in an uptrend, suggesting that the buyers were rejected. It generates a Bearish signal.
+// The same candle in a downtrend is an Inverted Hammer, which is not detected.
+//
+//	Upper Shadow >= Shadow Ratio * Body
+//	Lower Shadow <= Body
+//	Close > SMA(Trend Period, Closings)
+//
+// Example:
+//
+//	shootingStar := pattern.NewShootingStar[float64]()
+//	signals := shootingStar.Compute(openings, highs, lows, closings)
+type ShootingStar[T helper.Number] struct {
+	// ShadowRatio is the minimum ratio of the upper shadow to the body.
+	ShadowRatio float64
+
+	// Trend is the SMA instance defining the uptrend.
+	Trend *trend.Sma[T]
+}
+
+// NewShootingStar function initializes a new Shooting Star instance with the default parameters.
+func NewShootingStar[T helper.Number]() *ShootingStar[T] {
+	return NewShootingStarWith[T](DefaultShootingStarShadowRatio, DefaultShootingStarTrendPeriod)
+}
+
+// NewShootingStarWith function initializes a new Shooting Star instance with the given shadow ratio
+// and trend period.
+func NewShootingStarWith[T helper.Number](shadowRatio float64, trendPeriod int) *ShootingStar[T] {
+	return &ShootingStar[T]{
+		ShadowRatio: shadowRatio,
+		Trend:       trend.NewSmaWithPeriod[T](trendPeriod),
+	}
+}
+
+// Name returns the name of the pattern.
+func (*ShootingStar[T]) Name() string {
+	return "Shooting Star"
+}
+
+// Compute function takes channels of openings, highs, lows, and closings, and detects the Shooting Star pattern.
+func (s *ShootingStar[T]) Compute(openings, highs, lows, closings <-chan T) <-chan Signal {
+	closingsSplice := helper.Duplicate(closings, 2)
+
+	smas := s.Trend.Compute(closingsSplice[0])
+	cs := helper.Skip(candles(openings, highs, lows, closingsSplice[1]), s.Trend.IdlePeriod())
+
+	return helper.Operate(cs, smas, func(c *candle, sma T) Signal {
+		if c.close > float64(sma) &&
+			c.upperShadow() > 0 &&
+			c.upperShadow() >= s.ShadowRatio*c.body() &&
+			c.lowerShadow() <= c.body() {
+			return Bearish
+		}
+
+		return None
+	})
+}
+
+// IdlePeriod is the initial period that Shooting Star won't yield any results.
+func (s *ShootingStar[T]) IdlePeriod() int {
+	return s.Trend.IdlePeriod()
+}
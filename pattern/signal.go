@@ -0,0 +1,27 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package pattern
+
+// Signal represents the different signal categories that a candlestick pattern can generate.
+type Signal int
+
+const (
+	// None indicates that the pattern is not detected.
+	None Signal = 0
+
+	// Bullish indicates that a pattern suggesting a rise in the price is detected.
+	Bullish Signal = 1
+
+	// Bearish indicates that a pattern suggesting a decline in the price is detected.
+	Bearish Signal = -1
+
+	// Neutral indicates that a pattern suggesting indecision is detected.
+	Neutral Signal = 2
+)
+
+// IsDirectional returns true if the signal is either Bullish or Bearish.
+func (s Signal) IsDirectional() bool {
+	return s == Bullish || s == Bearish
+}
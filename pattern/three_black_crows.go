@@ -0,0 +1,56 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package pattern
+
+import "github.com/miromax42/indicator/v2/helper"
+
+// ThreeBlackCrows represents the configuration parameters for detecting the Three Black
+// Crows pattern. It consists of three consecutive bearish candles, each opening within
+// the body of the previous candle and closing below its closing. It generates a Bearish signal.
+//
+// Example:
+//
+//	threeBlackCrows := pattern.NewThreeBlackCrows[float64]()
+//	signals := threeBlackCrows.Compute(openings, highs, lows, closings)
+type ThreeBlackCrows[T helper.Number] struct{}
+
+// NewThreeBlackCrows function initializes a new Three Black Crows instance.
+func NewThreeBlackCrows[T helper.Number]() *ThreeBlackCrows[T] {
+	return &ThreeBlackCrows[T]{}
+}
+
+// Name returns the name of the pattern.
+func (*ThreeBlackCrows[T]) Name() string {
+	return "Three Black Crows"
+}
+
+// Compute function takes channels of openings, highs, lows, and closings, and detects the Three Black Crows pattern.
+func (t *ThreeBlackCrows[T]) Compute(openings, highs, lows, closings <-chan T) <-chan Signal {
+	windows := candleWindows(candles(openings, highs, lows, closings), t.IdlePeriod()+1)
+
+	return helper.Map(windows, func(w []*candle) Signal {
+		if !w[0].isBearish() {
+			return None
+		}
+
+		for i := 1; i < len(w); i++ {
+			previous, current := w[i-1], w[i]
+
+			if !current.isBearish() ||
+				current.open >= previous.open ||
+				current.open < previous.close ||
+				current.close >= previous.close {
+				return None
+			}
+		}
+
+		return Bearish
+	})
+}
+
+// IdlePeriod is the initial period that Three Black Crows won't yield any results.
+func (*ThreeBlackCrows[T]) IdlePeriod() int {
+	return 2
+}
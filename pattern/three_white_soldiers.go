@@ -0,0 +1,56 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package pattern
+
+import "github.com/miromax42/indicator/v2/helper"
+
+// ThreeWhiteSoldiers represents the configuration parameters for detecting the Three White
+// Soldiers pattern. It consists of three consecutive bullish candles, each opening within
+// the body of the previous candle and closing above its closing. It generates a Bullish signal.
+//
+// Example:
+//
+//	threeWhiteSoldiers := pattern.NewThreeWhiteSoldiers[float64]()
+//	signals := threeWhiteSoldiers.Compute(openings, highs, lows, closings)
+type ThreeWhiteSoldiers[T helper.Number] struct{}
+
+// NewThreeWhiteSoldiers function initializes a new Three White Soldiers instance.
+func NewThreeWhiteSoldiers[T helper.Number]() *ThreeWhiteSoldiers[T] {
+	return &ThreeWhiteSoldiers[T]{}
+}
+
+// Name returns the name of the pattern.
+func (*ThreeWhiteSoldiers[T]) Name() string {
+	return "Three White Soldiers"
+}
+
+// Compute function takes channels of openings, highs, lows, and closings, and detects the Three White Soldiers pattern.
+func (t *ThreeWhiteSoldiers[T]) Compute(openings, highs, lows, closings <-chan T) <-chan Signal {
+	windows := candleWindows(candles(openings, highs, lows, closings), t.IdlePeriod()+1)
+
+	return helper.Map(windows, func(w []*candle) Signal {
+		if !w[0].isBullish() {
+			return None
+		}
+
+		for i := 1; i < len(w); i++ {
+			previous, current := w[i-1], w[i]
+
+			if !current.isBullish() ||
+				current.open <= previous.open ||
+				current.open > previous.close ||
+				current.close <= previous.close {
+				return None
+			}
+		}
+
+		return Bullish
+	})
+}
+
+// IdlePeriod is the initial period that Three White Soldiers won't yield any results.
+func (*ThreeWhiteSoldiers[T]) IdlePeriod() int {
+	return 2
+}
@@ -24,10 +24,17 @@ const (
 	// recommendation usually implies that the strategy believes the
 	// asset's price is undervalued.
 	Buy Action = 1
+
+	// Short suggests borrowing and selling the asset to enter a short position,
+	// exiting the current long position first. This recommendation implies that
+	// the strategy expects the asset's price to decline. A following Buy covers
+	// the short position and enters a long position, and a following Sell only
+	// covers the short position.
+	Short Action = -2
 )
 
-// Annotation returns a single character string representing the recommended action.
-// It returns "S" for Sell, "B" for Buy, and an empty string for Hold.
+// Annotation returns a short string representing the recommended action.
+// It returns "S" for Sell, "B" for Buy, "SS" for Short, and an empty string for Hold.
 func (a Action) Annotation() string {
 	switch a {
 	case Sell:
@@ -36,6 +43,9 @@ func (a Action) Annotation() string {
 	case Buy:
 		return "B"
 
+	case Short:
+		return "SS"
+
 	default:
 		return ""
 	}
@@ -51,7 +61,9 @@ func ActionsToAnnotations(ac <-chan Action) <-chan string {
 
 // NormalizeActions transforms the given channel of actions to ensure a consistent and
 // predictable sequence. It eliminates consecutive occurrences of the same action
-// (Buy/Sell), ensuring the order follows a pattern of Hold, Buy, Hold, Sell.
+// (Buy/Sell/Short), ensuring the order follows a pattern of Hold, Buy, Hold, Sell.
+// As no position is held initially, a leading Sell is eliminated as well, while a
+// leading Short enters a short position.
 func NormalizeActions(ac <-chan Action) <-chan Action {
 	last := Sell
 
@@ -82,6 +94,7 @@ func DenormalizeActions(ac <-chan Action) <-chan Action {
 }
 
 // CountActions taken a slice of Action channels, and counts them by their type.
+// The Short actions are counted as Sell actions.
 func CountActions(acs []<-chan Action) (int, int, int, bool) {
 	var buy, hold, sell int
 
@@ -92,7 +105,7 @@ func CountActions(acs []<-chan Action) (int, int, int, bool) {
 		}
 
 		switch action {
-		case Sell:
+		case Sell, Short:
 			sell++
 
 		case Buy:
@@ -106,7 +119,7 @@ func CountActions(acs []<-chan Action) (int, int, int, bool) {
 	return buy, hold, sell, true
 }
 
-// CountTransactions counts the number of recommended Buy and Sell actions.
+// CountTransactions counts the number of recommended Buy, Sell, and Short actions.
 func CountTransactions(ac <-chan Action) <-chan int {
 	var transactions int
 
@@ -12,8 +12,8 @@ import (
 )
 
 func TestAnnotation(t *testing.T) {
-	actions := []strategy.Action{strategy.Hold, strategy.Buy, strategy.Sell}
-	annotations := []string{"", "B", "S"}
+	actions := []strategy.Action{strategy.Hold, strategy.Buy, strategy.Sell, strategy.Short}
+	annotations := []string{"", "B", "S", "SS"}
 
 	for i, action := range actions {
 		actual := action.Annotation()
@@ -56,6 +56,23 @@ func TestNormalizeActions(t *testing.T) {
 	}
 }
 
+func TestNormalizeActionsShort(t *testing.T) {
+	actions := helper.SliceToChan([]strategy.Action{
+		strategy.Short, strategy.Short, strategy.Buy, strategy.Short, strategy.Sell,
+	})
+
+	expected := helper.SliceToChan([]strategy.Action{
+		strategy.Short, strategy.Hold, strategy.Buy, strategy.Short, strategy.Sell,
+	})
+
+	actual := strategy.NormalizeActions(actions)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestDenormalizeActions(t *testing.T) {
 	actions := helper.SliceToChan([]strategy.Action{
 		strategy.Hold, strategy.Hold, strategy.Hold, strategy.Buy, strategy.Hold,
@@ -105,6 +122,23 @@ func TestCountActions(t *testing.T) {
 	}
 }
 
+func TestCountActionsShort(t *testing.T) {
+	chan1 := helper.SliceToChan[strategy.Action]([]strategy.Action{strategy.Short})
+	chan2 := helper.SliceToChan[strategy.Action]([]strategy.Action{strategy.Sell})
+
+	_, _, sell, ok := strategy.CountActions([]<-chan strategy.Action{
+		chan1, chan2,
+	})
+
+	if !ok {
+		t.Fatal("not ok")
+	}
+
+	if sell != 2 {
+		t.Fatalf("actual %v expected %v", sell, 2)
+	}
+}
+
 func TestCountActionsEmpty(t *testing.T) {
 	chan1 := helper.SliceToChan[strategy.Action]([]strategy.Action{})
 	chan2 := helper.SliceToChan[strategy.Action]([]strategy.Action{})
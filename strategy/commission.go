@@ -0,0 +1,116 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package strategy
+
+import "fmt"
+
+const (
+	// DefaultFixedCommission is the default commission amount charged per trade.
+	DefaultFixedCommission = 1.0
+
+	// DefaultPercentageCommission is the default commission percent of the trade value.
+	DefaultPercentageCommission = 0.1
+
+	// DefaultPerShareCommission is the default commission amount charged per share.
+	DefaultPerShareCommission = 0.005
+)
+
+// Commission defines the commission charged for a trade.
+type Commission interface {
+	// Commission returns the commission amount for trading the given
+	// number of shares at the given price.
+	Commission(price, shares float64) float64
+
+	// String is the string representation of the commission.
+	String() string
+}
+
+// FixedCommission charges a fixed amount per trade regardless of its size.
+type FixedCommission struct {
+	// Amount is the amount charged per trade.
+	Amount float64
+}
+
+// NewFixedCommission function initializes a new fixed commission instance with the default parameters.
+func NewFixedCommission() *FixedCommission {
+	return NewFixedCommissionWith(DefaultFixedCommission)
+}
+
+// NewFixedCommissionWith function initializes a new fixed commission instance with the given parameters.
+func NewFixedCommissionWith(amount float64) *FixedCommission {
+	return &FixedCommission{
+		Amount: amount,
+	}
+}
+
+// Commission returns the commission amount for the trade.
+func (f *FixedCommission) Commission(_, _ float64) float64 {
+	return f.Amount
+}
+
+// String is the string representation of the fixed commission.
+func (f *FixedCommission) String() string {
+	return fmt.Sprintf("Fixed Commission(%g)", f.Amount)
+}
+
+// PercentageCommission charges a percent of the trade value.
+//
+//	Commission = Price * Shares * Percent / 100
+type PercentageCommission struct {
+	// Percent is the percent of the trade value charged.
+	Percent float64
+}
+
+// NewPercentageCommission function initializes a new percentage commission instance with the default parameters.
+func NewPercentageCommission() *PercentageCommission {
+	return NewPercentageCommissionWith(DefaultPercentageCommission)
+}
+
+// NewPercentageCommissionWith function initializes a new percentage commission instance with the given parameters.
+func NewPercentageCommissionWith(percent float64) *PercentageCommission {
+	return &PercentageCommission{
+		Percent: percent,
+	}
+}
+
+// Commission returns the commission amount for the trade.
+func (p *PercentageCommission) Commission(price, shares float64) float64 {
+	return price * shares * p.Percent / 100
+}
+
+// String is the string representation of the percentage commission.
+func (p *PercentageCommission) String() string {
+	return fmt.Sprintf("Percentage Commission(%g%%)", p.Percent)
+}
+
+// PerShareCommission charges a fixed amount for each share traded.
+//
+//	Commission = Shares * Amount
+type PerShareCommission struct {
+	// Amount is the amount charged per share.
+	Amount float64
+}
+
+// NewPerShareCommission function initializes a new per share commission instance with the default parameters.
+func NewPerShareCommission() *PerShareCommission {
+	return NewPerShareCommissionWith(DefaultPerShareCommission)
+}
+
+// NewPerShareCommissionWith function initializes a new per share commission instance with the given parameters.
+func NewPerShareCommissionWith(amount float64) *PerShareCommission {
+	return &PerShareCommission{
+		Amount: amount,
+	}
+}
+
+// Commission returns the commission amount for the trade.
+func (p *PerShareCommission) Commission(_, shares float64) float64 {
+	return shares * p.Amount
+}
+
+// String is the string representation of the per share commission.
+func (p *PerShareCommission) String() string {
+	return fmt.Sprintf("Per Share Commission(%g)", p.Amount)
+}
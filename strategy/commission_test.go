@@ -0,0 +1,58 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package strategy_test
+
+import (
+	"testing"
+
+	"github.com/miromax42/indicator/v2/strategy"
+)
+
+func TestFixedCommission(t *testing.T) {
+	commission := strategy.NewFixedCommissionWith(2)
+
+	actual := commission.Commission(10, 100)
+	if actual != 2 {
+		t.Fatalf("actual %v expected %v", actual, 2)
+	}
+}
+
+func TestPercentageCommission(t *testing.T) {
+	commission := strategy.NewPercentageCommissionWith(0.5)
+
+	actual := commission.Commission(10, 100)
+	if actual != 5 {
+		t.Fatalf("actual %v expected %v", actual, 5)
+	}
+}
+
+func TestPerShareCommission(t *testing.T) {
+	commission := strategy.NewPerShareCommissionWith(0.01)
+
+	actual := commission.Commission(10, 100)
+	if actual != 1 {
+		t.Fatalf("actual %v expected %v", actual, 1)
+	}
+}
+
+func TestCommissionString(t *testing.T) {
+	commissions := []strategy.Commission{
+		strategy.NewFixedCommission(),
+		strategy.NewPercentageCommission(),
+		strategy.NewPerShareCommission(),
+	}
+
+	expected := []string{
+		"Fixed Commission(1)",
+		"Percentage Commission(0.1%)",
+		"Per Share Commission(0.005)",
+	}
+
+	for i, commission := range commissions {
+		if commission.String() != expected[i] {
+			t.Fatalf("actual %v expected %v", commission.String(), expected[i])
+		}
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package strategy
+
+import (
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+)
+
+// ComputeTransformed uses the given strategy to process the snapshots converted by the given transformer,
+// and generates, for each one of the provided snapshots, the actions recommended for the transformed
+// snapshots it completed. The strategy runs freely on the transformed snapshots, as the strategies may
+// read ahead of their actions, such as the ones skipping the idle periods of their indicators. The number
+// of transformed snapshots completed by each snapshot is buffered to map the actions back.
+//
+// Example:
+//
+//	actions := strategy.ComputeTransformed(trend.NewMacdStrategy(), asset.NewHeikinAshi(), snapshots)
+func ComputeTransformed(s Strategy, transformer asset.Transformer, snapshots <-chan *asset.Snapshot) <-chan []Action {
+	counts := make(chan int)
+	innerSnapshots := make(chan *asset.Snapshot)
+	innerActions := s.Compute(innerSnapshots)
+
+	go func() {
+		defer close(counts)
+		defer close(innerSnapshots)
+
+		for completed := range transformer.Transform(snapshots) {
+			counts <- len(completed)
+
+			for _, snapshot := range completed {
+				innerSnapshots <- snapshot
+			}
+		}
+	}()
+
+	result := make(chan []Action)
+
+	go func() {
+		defer close(result)
+
+		for count := range bufferCounts(counts) {
+			actions := make([]Action, count)
+
+			for i := range actions {
+				actions[i] = <-innerActions
+			}
+
+			result <- actions
+		}
+
+		helper.Drain(innerActions)
+	}()
+
+	return result
+}
+
+// bufferCounts buffers the given counts without a limit, so that the sender never waits for
+// the receiver. The number of buffered counts grows only as far as the strategy reads ahead.
+func bufferCounts(counts <-chan int) <-chan int {
+	result := make(chan int)
+
+	go func() {
+		defer close(result)
+
+		var pending []int
+
+		for counts != nil || len(pending) > 0 {
+			var next chan<- int
+			var first int
+
+			if len(pending) > 0 {
+				next = result
+				first = pending[0]
+			}
+
+			select {
+			case count, ok := <-counts:
+				if !ok {
+					counts = nil
+					continue
+				}
+
+				pending = append(pending, count)
+
+			case next <- first:
+				pending = pending[1:]
+			}
+		}
+	}()
+
+	return result
+}
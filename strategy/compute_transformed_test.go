@@ -0,0 +1,46 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package strategy_test
+
+import (
+	"testing"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/strategy"
+	"github.com/miromax42/indicator/v2/strategy/volatility"
+)
+
+func TestComputeTransformed(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/repository/brk-b.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshotsSlice := helper.ChanToSlice(snapshots)
+	resampler := asset.NewResampler(asset.OneWeek)
+
+	// Super Trend reads ahead of its actions.
+	superTrend := volatility.NewSuperTrendStrategy()
+
+	expected := superTrend.Compute(asset.Transform(helper.SliceToChan(snapshotsSlice), resampler))
+
+	var actual []strategy.Action
+	count := 0
+
+	for actions := range strategy.ComputeTransformed(superTrend, resampler, helper.SliceToChan(snapshotsSlice)) {
+		actual = append(actual, actions...)
+		count++
+	}
+
+	if count != len(snapshotsSlice) {
+		t.Fatalf("actual %v expected %v", count, len(snapshotsSlice))
+	}
+
+	err = helper.CheckEquals(helper.SliceToChan(actual), expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
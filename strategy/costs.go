@@ -0,0 +1,157 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package strategy
+
+import (
+	"math"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+)
+
+const (
+	// DefaultCostsCapital is the default capital invested on each trade, in the currency
+	// of the commissions. It is large enough for the default fixed and per share
+	// commissions to be a small fraction of each trade.
+	DefaultCostsCapital = 10000.0
+)
+
+// Costs defines the transaction costs applied when simulating the outcome of
+// the recommended actions.
+//
+// The outcomes are relative to the invested capital, so the capital only matters
+// for the commissions that do not scale with the trade value, such as the fixed
+// and the per share commissions. It should be a realistic trade size, as the
+// default fixed commission of 1 would be the entire trade for a capital of 1.
+type Costs struct {
+	// Capital is the capital invested on each trade, in the same currency as
+	// the commission.
+	Capital float64
+
+	// Commission is the commission charged for each trade.
+	Commission Commission
+
+	// Slippage is the slippage of the price for each trade.
+	Slippage Slippage
+}
+
+// NewCosts function initializes a new costs instance without any commission or slippage.
+func NewCosts() *Costs {
+	return NewCostsWith(NewFixedCommissionWith(0), NewFixedSlippageWith(0))
+}
+
+// NewCostsWith function initializes a new costs instance with the given commission and slippage.
+func NewCostsWith(commission Commission, slippage Slippage) *Costs {
+	return &Costs{
+		Capital:    DefaultCostsCapital,
+		Commission: commission,
+		Slippage:   slippage,
+	}
+}
+
+// OutcomeWithCosts simulates the potential result of executing the given actions based on
+// the provided snapshots, like the Outcome function, while buying, or covering a short position,
+// at the closing price plus the slippage, selling, or entering a short position, at the closing
+// price minus the slippage, and paying the commission for each trade. It returns the outcomes and the cumulative transaction costs, both
+// relative to the invested capital. The two channels must be consumed concurrently.
+func OutcomeWithCosts(snapshots <-chan *asset.Snapshot, actions <-chan Action, costs *Costs) (<-chan float64, <-chan float64) {
+	outcomes := make(chan float64, cap(snapshots))
+	totals := make(chan float64, cap(snapshots))
+
+	go func() {
+		defer close(outcomes)
+		defer close(totals)
+
+		balance := 0.
+		shares := 0.
+		total := 0.
+
+		// No position is held initially.
+		position := Sell
+
+		for snapshot := range snapshots {
+			action, ok := <-actions
+			if !ok {
+				break
+			}
+
+			if action != Hold && action != position {
+				// Exit the current long or short position.
+				if shares != 0 {
+					quantity := math.Abs(shares)
+
+					// Selling the long position slips down, and covering the short position slips up.
+					slippage := costs.Slippage.Slippage(snapshot, quantity)
+					price := snapshot.Close - math.Copysign(slippage, shares)
+
+					commission := costs.Commission.Commission(price, quantity)
+
+					balance += shares*price - commission
+					total += commission + slippage*quantity
+					shares = 0
+				}
+
+				if action == Buy {
+					slippage := costs.Slippage.Slippage(snapshot, costs.Capital/snapshot.Close)
+					price := snapshot.Close + slippage
+
+					shares = costs.Capital / price
+					commission := costs.Commission.Commission(price, shares)
+
+					balance -= costs.Capital + commission
+					total += commission + slippage*shares
+				} else if action == Short {
+					slippage := costs.Slippage.Slippage(snapshot, costs.Capital/snapshot.Close)
+					price := snapshot.Close - slippage
+
+					shares = -costs.Capital / price
+					commission := costs.Commission.Commission(price, -shares)
+
+					balance += costs.Capital - commission
+					total += commission - slippage*shares
+				}
+
+				position = action
+			}
+
+			outcomes <- (balance + shares*snapshot.Close) / costs.Capital
+			totals <- total / costs.Capital
+		}
+
+		// Drain the remaining inputs as they may share a common source.
+		go helper.Drain(snapshots)
+		helper.Drain(actions)
+	}()
+
+	return outcomes, totals
+}
+
+// ReportWithCosts processes the provided asset snapshots and generates the report of the
+// given strategy, like its Report method, with the outcomes replaced by the given outcomes
+// after the transaction costs, and the given cumulative transaction costs plotted next to
+// them. The outcomes and the costs are relative to the invested capital. They are read as
+// the report is written, so they should be computed from their own copy of the snapshots.
+//
+// Example:
+//
+//	actions, outcomes, totals := strategy.ComputeWithOutcomeAndCosts(s, helper.SliceToChan(snapshots), costs)
+//	go helper.Drain(actions)
+//
+//	report := strategy.ReportWithCosts(s, helper.SliceToChan(snapshots), outcomes, totals)
+func ReportWithCosts(s Strategy, snapshots <-chan *asset.Snapshot, outcomes, costs <-chan float64) *helper.Report {
+	report := s.Report(snapshots)
+
+	outcomesColumn := helper.NewNumericReportColumn("Outcome", helper.MultiplyBy(outcomes, 100))
+
+	charts := report.ReplaceColumn("Outcome", outcomesColumn)
+	if len(charts) == 0 {
+		charts = []int{report.AddChart()}
+		report.AddColumn(outcomesColumn, charts...)
+	}
+
+	report.AddColumn(helper.NewNumericReportColumn("Costs", helper.MultiplyBy(costs, 100)), charts...)
+
+	return report
+}
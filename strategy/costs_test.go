@@ -0,0 +1,266 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package strategy_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/strategy"
+)
+
+func TestOutcomeWithCostsWithoutCosts(t *testing.T) {
+	closings := []float64{
+		10, 15, 12, 12, 18,
+		20, 22, 25, 24, 20,
+	}
+
+	actions := []strategy.Action{
+		strategy.Hold, strategy.Hold, strategy.Buy, strategy.Buy, strategy.Hold,
+		strategy.Hold, strategy.Hold, strategy.Sell, strategy.Hold, strategy.Hold,
+	}
+
+	snapshots := helper.Map(helper.SliceToChan(closings), func(closing float64) *asset.Snapshot {
+		return &asset.Snapshot{Close: closing}
+	})
+
+	outcomes, costs := strategy.OutcomeWithCosts(snapshots, helper.SliceToChan(actions), strategy.NewCosts())
+	go helper.Drain(costs)
+
+	expected := strategy.Outcome(helper.SliceToChan(closings), helper.SliceToChan(actions))
+
+	// The outcomes are computed for the default capital instead of a single unit.
+	err := helper.CheckEquals(helper.RoundDigits(outcomes, 8), helper.RoundDigits(expected, 8))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOutcomeWithCosts(t *testing.T) {
+	snapshots := helper.SliceToChan([]*asset.Snapshot{
+		{Close: 10},
+		{Close: 20},
+		{Close: 20},
+		{Close: 40},
+	})
+
+	actions := helper.SliceToChan([]strategy.Action{
+		strategy.Buy, strategy.Hold, strategy.Hold, strategy.Sell,
+	})
+
+	costs := strategy.NewCostsWith(
+		strategy.NewFixedCommissionWith(10),
+		strategy.NewFixedSlippageWith(0),
+	)
+	costs.Capital = 100
+
+	// Buys 10 shares for 100 and pays 10, then sells them for 400 and pays 10.
+	outcomes, totals := strategy.OutcomeWithCosts(snapshots, actions, costs)
+
+	expectedOutcomes := helper.SliceToChan([]float64{-0.1, 0.9, 0.9, 2.8})
+	expectedTotals := helper.SliceToChan([]float64{0.1, 0.1, 0.1, 0.2})
+
+	totalsSlice := make(chan []float64, 1)
+	go func() {
+		totalsSlice <- helper.ChanToSlice(totals)
+	}()
+
+	err := helper.CheckEquals(helper.RoundDigits(outcomes, 2), expectedOutcomes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = helper.CheckEquals(helper.RoundDigits(helper.SliceToChan(<-totalsSlice), 2), expectedTotals)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOutcomeWithCostsSlippage(t *testing.T) {
+	snapshots := helper.SliceToChan([]*asset.Snapshot{
+		{High: 11, Low: 9, Close: 10},
+		{High: 11, Low: 9, Close: 10},
+	})
+
+	actions := helper.SliceToChan([]strategy.Action{
+		strategy.Buy, strategy.Sell,
+	})
+
+	costs := strategy.NewCostsWith(
+		strategy.NewFixedCommissionWith(0),
+		strategy.NewRangeSlippageWith(0.5),
+	)
+
+	// Buys at 11 and sells at 9.
+	outcomes, totals := strategy.OutcomeWithCosts(snapshots, actions, costs)
+	go helper.Drain(totals)
+
+	actual := helper.ChanToSlice(helper.RoundDigits(outcomes, 4))
+	if actual[1] != -0.1818 {
+		t.Fatalf("actual %v expected %v", actual[1], -0.1818)
+	}
+}
+
+func TestComputeWithOutcomeAndCosts(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/repository/brk-b.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshotsSplice := helper.Duplicate(snapshots, 2)
+
+	costs := strategy.NewCostsWith(
+		strategy.NewPercentageCommissionWith(0.1),
+		strategy.NewFixedSlippageWith(5),
+	)
+
+	buyAndHold := strategy.NewBuyAndHoldStrategy()
+
+	actions, outcomes := strategy.ComputeWithOutcome(buyAndHold, snapshotsSplice[0])
+	go helper.Drain(actions)
+	outcome := helper.Last(outcomes, 1)
+
+	actions, outcomes, totals := strategy.ComputeWithOutcomeAndCosts(buyAndHold, snapshotsSplice[1], costs)
+	go helper.Drain(actions)
+	outcomeWithCosts := helper.Last(outcomes, 1)
+	total := helper.Last(totals, 1)
+
+	expected := <-outcome
+	actual := <-outcomeWithCosts
+
+	if actual >= expected {
+		t.Fatalf("actual %v expected less than %v", actual, expected)
+	}
+
+	if <-total <= 0 {
+		t.Fatal("expected positive costs")
+	}
+}
+
+func TestOutcomeWithCostsShort(t *testing.T) {
+	snapshots := helper.SliceToChan([]*asset.Snapshot{
+		{High: 11, Low: 9, Close: 10},
+		{High: 6, Low: 4, Close: 5},
+	})
+
+	actions := helper.SliceToChan([]strategy.Action{
+		strategy.Short, strategy.Sell,
+	})
+
+	costs := strategy.NewCostsWith(
+		strategy.NewFixedCommissionWith(0),
+		strategy.NewRangeSlippageWith(0.5),
+	)
+
+	// Shorts at 9 and covers at 6.
+	outcomes, totals := strategy.OutcomeWithCosts(snapshots, actions, costs)
+
+	totalsSlice := make(chan []float64, 1)
+	go func() {
+		totalsSlice <- helper.ChanToSlice(totals)
+	}()
+
+	actual := helper.ChanToSlice(helper.RoundDigits(outcomes, 4))
+	if actual[1] != 0.3333 {
+		t.Fatalf("actual %v expected %v", actual[1], 0.3333)
+	}
+
+	// Slipped 1 on 1/9 shares on both trades.
+	actualTotals := <-totalsSlice
+	if helper.RoundDigit(actualTotals[1], 4) != 0.2222 {
+		t.Fatalf("actual %v expected %v", actualTotals[1], 0.2222)
+	}
+}
+
+func TestOutcomeWithCostsDefaultCommissions(t *testing.T) {
+	tests := []struct {
+		commission strategy.Commission
+	}{
+		{commission: strategy.NewFixedCommission()},
+		{commission: strategy.NewPerShareCommission()},
+	}
+
+	for _, test := range tests {
+		snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/repository/brk-b.csv", true)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		snapshotsSlice := helper.ChanToSlice(snapshots)
+
+		// Enters and exits a position every 20 days.
+		actions := make([]strategy.Action, len(snapshotsSlice))
+		for i := range actions {
+			switch i % 40 {
+			case 0:
+				actions[i] = strategy.Buy
+			case 20:
+				actions[i] = strategy.Sell
+			default:
+				actions[i] = strategy.Hold
+			}
+		}
+
+		costs := strategy.NewCostsWith(test.commission, strategy.NewFixedSlippageWith(0))
+
+		outcomes, totals := strategy.OutcomeWithCosts(helper.SliceToChan(snapshotsSlice), helper.SliceToChan(actions), costs)
+		outcome := helper.Last(outcomes, 1)
+		total := helper.Last(totals, 1)
+
+		expected := <-helper.Last(strategy.Outcome(asset.SnapshotsAsClosings(helper.SliceToChan(snapshotsSlice)), helper.SliceToChan(actions)), 1)
+		actualTotal := <-total
+		actual := <-outcome
+
+		// Each trade costs a small fraction of the capital.
+		if actualTotal <= 0 || actualTotal > 0.01 {
+			t.Fatalf("%s costs %v expected between 0 and 0.01", test.commission, actualTotal)
+		}
+
+		if helper.RoundDigit(expected-actual, 6) != helper.RoundDigit(actualTotal, 6) {
+			t.Fatalf("%s actual %v expected %v", test.commission, actual, expected-actualTotal)
+		}
+	}
+}
+
+func TestReportWithCosts(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/repository/brk-b.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshotsSlice := helper.ChanToSlice(snapshots)
+
+	costs := strategy.NewCostsWith(
+		strategy.NewPercentageCommissionWith(0.1),
+		strategy.NewFixedSlippageWith(5),
+	)
+
+	bah := strategy.NewBuyAndHoldStrategy()
+
+	actions, outcomes, totals := strategy.ComputeWithOutcomeAndCosts(bah, helper.SliceToChan(snapshotsSlice), costs)
+	go helper.Drain(actions)
+
+	report := strategy.ReportWithCosts(bah, helper.SliceToChan(snapshotsSlice), outcomes, totals)
+
+	fileName := "report_with_costs.html"
+	defer os.Remove(fileName)
+
+	err = report.WriteToFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(content), "Costs") {
+		t.Fatal("expected costs column")
+	}
+}
@@ -13,7 +13,7 @@ import (
 )
 
 // InverseStrategy reverses the advice of another strategy. For example, if the original strategy suggests buying an
-// asset, InverseStrategy would recommend selling it.
+// asset, InverseStrategy would recommend selling it. A recommendation to short the asset is reversed to buying it.
 type InverseStrategy struct {
 	// InnerStrategy is the inner strategy.
 	InnerStrategy strategy.Strategy
@@ -38,7 +38,7 @@ func (i *InverseStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strat
 		case strategy.Buy:
 			return strategy.Sell
 
-		case strategy.Sell:
+		case strategy.Sell, strategy.Short:
 			return strategy.Buy
 
 		default:
@@ -0,0 +1,67 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package decorator
+
+import (
+	"fmt"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/strategy"
+)
+
+// LongShortStrategy evaluates the advice of another strategy as a long and short strategy. When the original
+// strategy suggests selling an asset, LongShortStrategy would recommend shorting it instead, reversing from the
+// long position to a short position. When the original strategy suggests buying an asset, it would cover the
+// short position and reverse to a long position.
+type LongShortStrategy struct {
+	// InnerStrategy is the inner strategy.
+	InnerStrategy strategy.Strategy
+}
+
+// NewLongShortStrategy function initializes a new long short strategy instance.
+func NewLongShortStrategy(innerStrategy strategy.Strategy) *LongShortStrategy {
+	return &LongShortStrategy{
+		InnerStrategy: innerStrategy,
+	}
+}
+
+// Name returns the name of the strategy.
+func (l *LongShortStrategy) Name() string {
+	return fmt.Sprintf("Long Short Strategy (%s)", l.InnerStrategy.Name())
+}
+
+// Compute processes the provided asset snapshots and generates a stream of actionable recommendations.
+func (l *LongShortStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	return helper.Map(l.InnerStrategy.Compute(snapshots), func(action strategy.Action) strategy.Action {
+		if action == strategy.Sell {
+			return strategy.Short
+		}
+
+		return action
+	})
+}
+
+// Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
+func (l *LongShortStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	snapshots := helper.Duplicate(c, 3)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	closings := asset.SnapshotsAsClosings(snapshots[1])
+
+	actions, outcomes := strategy.ComputeWithOutcome(l, snapshots[2])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(l.Name(), dates)
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings))
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations))
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 1)
+
+	return report
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package decorator_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/strategy"
+	"github.com/miromax42/indicator/v2/strategy/decorator"
+	"github.com/miromax42/indicator/v2/strategy/trend"
+)
+
+func TestLongShortStrategy(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := helper.ReadFromCsvFile[strategy.Result]("testdata/long_short_strategy.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := helper.Map(results, func(r *strategy.Result) strategy.Action { return r.Action })
+
+	innerStrategy := trend.NewMacdStrategy()
+	strategy := decorator.NewLongShortStrategy(innerStrategy)
+
+	actual := strategy.Compute(snapshots)
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLongShortStrategyReport(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	innerStrategy := trend.NewMacdStrategy()
+	strategy := decorator.NewLongShortStrategy(innerStrategy)
+
+	report := strategy.Report(snapshots)
+
+	fileName := "long_short_strategy.html"
+	defer os.Remove(fileName)
+
+	err = report.WriteToFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLongShortStrategyOutcome(t *testing.T) {
+	snapshots := helper.SliceToChan([]*asset.Snapshot{
+		{Close: 10},
+		{Close: 8},
+		{Close: 5},
+		{Close: 10},
+	})
+
+	innerStrategy := &fixedStrategy{
+		actions: []strategy.Action{strategy.Sell, strategy.Hold, strategy.Buy, strategy.Hold},
+	}
+
+	actions, outcomes := strategy.ComputeWithOutcome(decorator.NewLongShortStrategy(innerStrategy), snapshots)
+	go helper.Drain(actions)
+
+	expected := helper.SliceToChan([]float64{0, 0.2, 0.5, 1.5})
+
+	err := helper.CheckEquals(helper.RoundDigits(outcomes, 2), expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// fixedStrategy recommends the given actions in order.
+type fixedStrategy struct {
+	actions []strategy.Action
+}
+
+func (*fixedStrategy) Name() string {
+	return "Fixed Strategy"
+}
+
+func (f *fixedStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	go helper.Drain(snapshots)
+	return helper.SliceToChan(f.actions)
+}
+
+func (f *fixedStrategy) Report(snapshots <-chan *asset.Snapshot) *helper.Report {
+	return helper.NewReport(f.Name(), asset.SnapshotsAsDates(snapshots))
+}
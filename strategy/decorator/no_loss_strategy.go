@@ -13,7 +13,8 @@ import (
 )
 
 // NoLossStrategy prevents selling an asset at a loss. It modifies the recommendations of another strategy to ensure
-// that the asset is only sold if its value is at or above the original purchase price.
+// that the asset is only sold if its value is above the original purchase price. Likewise, a short position is
+// only covered, or reversed by a Buy, if the value of the asset is below the original short price.
 type NoLossStrategy struct {
 	// InnertStrategy is the inner strategy.
 	InnertStrategy strategy.Strategy
@@ -37,19 +38,33 @@ func (n *NoLossStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strate
 
 	innerActions := n.InnertStrategy.Compute(snapshotsSplice[0])
 	closings := asset.SnapshotsAsClosings(snapshotsSplice[1])
-	boughtAt := 0.0
+
+	// No position is held initially.
+	position := strategy.Sell
+	enteredAt := 0.0
 
 	return helper.Operate(innerActions, closings, func(action strategy.Action, closing float64) strategy.Action {
-		// If action is Buy and the asset is not yet bought, buy it as recommended.
-		if action == strategy.Buy && boughtAt == 0.0 {
-			boughtAt = closing
-			return strategy.Buy
+		if action == strategy.Hold || action == position {
+			return strategy.Hold
+		}
+
+		// If no position is held, enter the position as recommended.
+		if position == strategy.Sell {
+			if action == strategy.Sell {
+				return strategy.Hold
+			}
+
+			position = action
+			enteredAt = closing
+			return action
 		}
 
-		// If the action is sell and the asset was bought at a lower amount, sell it as recommended.
-		if action == strategy.Sell && boughtAt != 0.0 && boughtAt < closing {
-			boughtAt = 0.0
-			return strategy.Sell
+		// If the long position was entered at a lower amount, or the short position at a
+		// higher amount, exit it, or reverse it, as recommended.
+		if (position == strategy.Buy && enteredAt < closing) || (position == strategy.Short && enteredAt > closing) {
+			position = action
+			enteredAt = closing
+			return action
 		}
 
 		return strategy.Hold
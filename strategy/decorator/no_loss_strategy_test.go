@@ -58,3 +58,37 @@ func TestNoLossStrategyReport(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestNoLossStrategyShort(t *testing.T) {
+	tests := []struct {
+		closings []float64
+		actions  []strategy.Action
+		expected []strategy.Action
+	}{
+		{
+			// Reverses the short position at a profit, and sells the long position at a profit.
+			closings: []float64{10, 9, 11, 12, 10},
+			actions:  []strategy.Action{strategy.Short, strategy.Buy, strategy.Buy, strategy.Sell, strategy.Short},
+			expected: []strategy.Action{strategy.Short, strategy.Buy, strategy.Hold, strategy.Sell, strategy.Short},
+		},
+		{
+			// Keeps the short position at a loss.
+			closings: []float64{10, 11, 9, 12},
+			actions:  []strategy.Action{strategy.Short, strategy.Buy, strategy.Buy, strategy.Short},
+			expected: []strategy.Action{strategy.Short, strategy.Hold, strategy.Buy, strategy.Short},
+		},
+	}
+
+	for _, test := range tests {
+		snapshots := helper.Map(helper.SliceToChan(test.closings), func(closing float64) *asset.Snapshot {
+			return &asset.Snapshot{Close: closing}
+		})
+
+		noLoss := decorator.NewNoLossStrategy(&fixedStrategy{actions: test.actions})
+
+		err := helper.CheckEquals(noLoss.Compute(snapshots), helper.SliceToChan(test.expected))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -13,6 +13,7 @@ import (
 )
 
 // StopLossStrategy prevents a loss by recommending a sell action when the assets drops below the given threshold.
+// A short position is covered likewise when the asset rises above the given threshold.
 type StopLossStrategy struct {
 	// InnertStrategy is the inner strategy.
 	InnertStrategy strategy.Strategy
@@ -50,17 +51,30 @@ func (s *StopLossStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan stra
 
 	innerActions := s.InnertStrategy.Compute(snapshotsSplice[0])
 	closings := asset.SnapshotsAsClosings(snapshotsSplice[1])
+
+	// No position is held initially.
+	position := strategy.Sell
 	stopLossAt := 0.0
 
 	return helper.Operate(innerActions, closings, func(action strategy.Action, closing float64) strategy.Action {
-		// If action is Buy and the asset is not yet bought, buy it as recommended.
-		if action == strategy.Buy && stopLossAt == 0.0 {
-			stopLossAt = closing * (1 - s.Percentage)
-			return strategy.Buy
+		// If action is Buy or Short and the asset is not yet in that position, enter it as recommended.
+		if (action == strategy.Buy || action == strategy.Short) && action != position {
+			position = action
+
+			if action == strategy.Buy {
+				stopLossAt = closing * (1 - s.Percentage)
+			} else {
+				stopLossAt = closing * (1 + s.Percentage)
+			}
+
+			return action
 		}
 
-		// If asset is bought and action is sell or closing is less than or equal to stop loss at, recommend sell.
-		if stopLossAt != 0 && (action == strategy.Sell || closing <= stopLossAt) {
+		// If a position is held and action is sell or closing crosses the stop loss at, recommend sell.
+		stopped := (position == strategy.Buy && closing <= stopLossAt) || (position == strategy.Short && closing >= stopLossAt)
+
+		if position != strategy.Sell && (action == strategy.Sell || stopped) {
+			position = strategy.Sell
 			stopLossAt = 0.0
 			return strategy.Sell
 		}
@@ -58,3 +58,37 @@ func TestStopLossStrategyReport(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestStopLossStrategyShort(t *testing.T) {
+	tests := []struct {
+		closings []float64
+		actions  []strategy.Action
+		expected []strategy.Action
+	}{
+		{
+			// Covers the short position once the closing rises above the stop loss.
+			closings: []float64{10, 10.1, 10.3, 10, 9},
+			actions:  []strategy.Action{strategy.Short, strategy.Hold, strategy.Hold, strategy.Buy, strategy.Sell},
+			expected: []strategy.Action{strategy.Short, strategy.Hold, strategy.Sell, strategy.Buy, strategy.Sell},
+		},
+		{
+			// Reverses the positions, and stops the last long position.
+			closings: []float64{10, 10.1, 10.3, 10, 9.5},
+			actions:  []strategy.Action{strategy.Buy, strategy.Short, strategy.Hold, strategy.Buy, strategy.Hold},
+			expected: []strategy.Action{strategy.Buy, strategy.Short, strategy.Hold, strategy.Buy, strategy.Sell},
+		},
+	}
+
+	for _, test := range tests {
+		snapshots := helper.Map(helper.SliceToChan(test.closings), func(closing float64) *asset.Snapshot {
+			return &asset.Snapshot{Close: closing}
+		})
+
+		stopLoss := decorator.NewStopLossStrategy(&fixedStrategy{actions: test.actions}, 0.02)
+
+		err := helper.CheckEquals(stopLoss.Compute(snapshots), helper.SliceToChan(test.expected))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
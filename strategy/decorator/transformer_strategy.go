@@ -0,0 +1,77 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package decorator
+
+import (
+	"fmt"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/strategy"
+)
+
+// TransformerStrategy runs the inner strategy on the snapshots converted by the transformer, such as
+// Heikin-Ashi candles or Renko bricks. The recommended actions are mapped back to the original snapshots,
+// so that the outcomes are still based on the actual prices. A snapshot gets the last action other than
+// Hold recommended for the transformed snapshots it completed, and Hold if it completed none.
+type TransformerStrategy struct {
+	// InnerStrategy is the inner strategy.
+	InnerStrategy strategy.Strategy
+
+	// Transformer is the snapshot transformer.
+	Transformer asset.Transformer
+}
+
+// NewTransformerStrategy function initializes a new transformer strategy instance.
+func NewTransformerStrategy(innerStrategy strategy.Strategy, transformer asset.Transformer) *TransformerStrategy {
+	return &TransformerStrategy{
+		InnerStrategy: innerStrategy,
+		Transformer:   transformer,
+	}
+}
+
+// Name returns the name of the strategy.
+func (t *TransformerStrategy) Name() string {
+	return fmt.Sprintf("%s (%s)", t.Transformer.Name(), t.InnerStrategy.Name())
+}
+
+// Compute processes the provided asset snapshots and generates a stream of actionable recommendations.
+func (t *TransformerStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	innerActions := strategy.ComputeTransformed(t.InnerStrategy, t.Transformer, snapshots)
+
+	return helper.Map(innerActions, func(innerActions []strategy.Action) strategy.Action {
+		action := strategy.Hold
+
+		for _, innerAction := range innerActions {
+			if innerAction != strategy.Hold {
+				action = innerAction
+			}
+		}
+
+		return action
+	})
+}
+
+// Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
+func (t *TransformerStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	snapshots := helper.Duplicate(c, 3)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	closings := asset.SnapshotsAsClosings(snapshots[1])
+
+	actions, outcomes := strategy.ComputeWithOutcome(t, snapshots[2])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(t.Name(), dates)
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings))
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations))
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 1)
+
+	return report
+}
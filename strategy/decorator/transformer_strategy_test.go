@@ -0,0 +1,123 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package decorator_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/strategy"
+	"github.com/miromax42/indicator/v2/strategy/compound"
+	"github.com/miromax42/indicator/v2/strategy/decorator"
+	"github.com/miromax42/indicator/v2/strategy/momentum"
+	"github.com/miromax42/indicator/v2/strategy/pattern"
+	"github.com/miromax42/indicator/v2/strategy/trend"
+	"github.com/miromax42/indicator/v2/strategy/volatility"
+	"github.com/miromax42/indicator/v2/strategy/volume"
+)
+
+func TestTransformerStrategy(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := helper.ReadFromCsvFile[strategy.Result]("testdata/transformer_strategy.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := helper.Map(results, func(r *strategy.Result) strategy.Action { return r.Action })
+
+	innerStrategy := trend.NewMacdStrategy()
+	strategy := decorator.NewTransformerStrategy(innerStrategy, asset.NewHeikinAshi())
+
+	actual := strategy.Compute(snapshots)
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTransformerStrategyWithRenko(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := helper.ReadFromCsvFile[strategy.Result]("testdata/renko_transformer_strategy.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := helper.Map(results, func(r *strategy.Result) strategy.Action { return r.Action })
+
+	innerStrategy := trend.NewMacdStrategy()
+	strategy := decorator.NewTransformerStrategy(innerStrategy, asset.NewRenkoWithSize(2))
+
+	actual := strategy.Compute(snapshots)
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTransformerStrategyAllStrategies(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshotsSlice := helper.ChanToSlice(snapshots)
+
+	var innerStrategies []strategy.Strategy
+	innerStrategies = append(innerStrategies, compound.AllStrategies()...)
+	innerStrategies = append(innerStrategies, momentum.AllStrategies()...)
+	innerStrategies = append(innerStrategies, pattern.AllStrategies()...)
+	innerStrategies = append(innerStrategies, strategy.AllStrategies()...)
+	innerStrategies = append(innerStrategies, trend.AllStrategies()...)
+	innerStrategies = append(innerStrategies, volatility.AllStrategies()...)
+	innerStrategies = append(innerStrategies, volume.AllStrategies()...)
+
+	transformers := []asset.Transformer{
+		asset.NewHeikinAshi(),
+		asset.NewRenkoWithSize(2),
+	}
+
+	for _, transformer := range transformers {
+		for _, innerStrategy := range innerStrategies {
+			strategy := decorator.NewTransformerStrategy(innerStrategy, transformer)
+
+			actual := len(helper.ChanToSlice(strategy.Compute(helper.SliceToChan(snapshotsSlice))))
+			if actual != len(snapshotsSlice) {
+				t.Fatalf("%s actual %v expected %v", strategy.Name(), actual, len(snapshotsSlice))
+			}
+		}
+	}
+}
+
+func TestTransformerStrategyReport(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	innerStrategy := trend.NewMacdStrategy()
+	strategy := decorator.NewTransformerStrategy(innerStrategy, asset.NewRenko())
+
+	report := strategy.Report(snapshots)
+
+	fileName := "transformer_strategy.html"
+	defer os.Remove(fileName)
+
+	err = report.WriteToFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
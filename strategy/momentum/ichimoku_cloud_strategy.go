@@ -0,0 +1,181 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package momentum
+
+import (
+	"math"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/momentum"
+	"github.com/miromax42/indicator/v2/strategy"
+)
+
+// IchimokuCloudStrategy represents the configuration parameters for calculating the Ichimoku Cloud strategy.
+// It follows the classic cloud rules. The conversion line crossing above the base line recommends a Buy action
+// when the closing is above the cloud, and the lagging span confirms it with the closing being above the
+// closing of the lagging period ago. The conversion line crossing below the base line recommends a Sell
+// action when the closing is below the cloud, and the lagging span confirms it with the closing being below
+// the closing of the lagging period ago. As in the classic chart, the leading spans are displaced forward by
+// the base period, so the closing is compared with the cloud computed the base period ago.
+type IchimokuCloudStrategy struct {
+	// IchimokuCloud represents the configuration parameters for calculating the Ichimoku Cloud.
+	IchimokuCloud *momentum.IchimokuCloud[float64]
+}
+
+// NewIchimokuCloudStrategy function initializes a new Ichimoku Cloud strategy with the default parameters.
+func NewIchimokuCloudStrategy() *IchimokuCloudStrategy {
+	return &IchimokuCloudStrategy{
+		IchimokuCloud: momentum.NewIchimokuCloud[float64](),
+	}
+}
+
+// Name returns the name of the strategy.
+func (*IchimokuCloudStrategy) Name() string {
+	return "Ichimoku Cloud Strategy"
+}
+
+// Compute processes the provided asset snapshots and generates a stream of actionable recommendations.
+func (i *IchimokuCloudStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	snapshotsSplice := helper.Duplicate(snapshots, 3)
+
+	highs := asset.SnapshotsAsHighs(snapshotsSplice[0])
+	lows := asset.SnapshotsAsLows(snapshotsSplice[1])
+	closings := helper.Duplicate(asset.SnapshotsAsClosings(snapshotsSplice[2]), 3)
+
+	conversionLine, baseLine, leadingSpanA, leadingSpanB, laggingLine := i.IchimokuCloud.Compute(highs, lows, closings[0])
+
+	closings[1] = helper.Skip(closings[1], i.IchimokuCloud.IdlePeriod())
+	closings[2] = helper.Skip(closings[2], i.IchimokuCloud.IdlePeriod())
+
+	// Displace the leading spans forward by the base period. The cloud is not yet known
+	// during the displacement, and comparing with NaN places the closing inside it.
+	leadingSpanA = helper.Shift(leadingSpanA, i.IchimokuCloud.BaseMax.Period, math.NaN())
+	leadingSpanB = helper.Shift(leadingSpanB, i.IchimokuCloud.BaseMax.Period, math.NaN())
+
+	// Position of the closing relative to the cloud, 1 for above, -1 for below, 0 for inside.
+	clouds := helper.Operate3(closings[1], leadingSpanA, leadingSpanB, func(closing, spanA, spanB float64) float64 {
+		if closing > max(spanA, spanB) {
+			return 1
+		}
+
+		if closing < min(spanA, spanB) {
+			return -1
+		}
+
+		return 0
+	})
+
+	// Lagging span confirmation, 1 for bullish, -1 for bearish.
+	confirmations := helper.Operate(closings[2], laggingLine, func(closing, lagging float64) float64 {
+		if closing > lagging {
+			return 1
+		}
+
+		if closing < lagging {
+			return -1
+		}
+
+		return 0
+	})
+
+	biases := helper.Operate(clouds, confirmations, func(cloud, confirmation float64) float64 {
+		if cloud == confirmation {
+			return cloud
+		}
+
+		return 0
+	})
+
+	first := true
+	var previousConversion, previousBase float64
+
+	actions := helper.Operate3(conversionLine, baseLine, biases, func(conversion, base, bias float64) strategy.Action {
+		action := strategy.Hold
+
+		if !first {
+			if bias > 0 && previousConversion <= previousBase && conversion > base {
+				action = strategy.Buy
+			} else if bias < 0 && previousConversion >= previousBase && conversion < base {
+				action = strategy.Sell
+			}
+		}
+
+		first = false
+		previousConversion = conversion
+		previousBase = base
+
+		return action
+	})
+
+	// Ichimoku Cloud starts only after the idle period.
+	actions = helper.Shift(actions, i.IchimokuCloud.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
+
+// Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
+func (i *IchimokuCloudStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> Compute     -> actions -> annotations
+	// snapshots[2] -> closings[0] -> close
+	// snapshots[3] -> highs       |
+	// snapshots[4] -> lows        |
+	//                 closings[1] -> IchimokuCloud.Compute -> conversion, base, spanA, spanB, lagging
+	//                 closings[2] -> spanA length
+	//                 closings[3] -> spanB length
+	//
+	snapshots := helper.Duplicate(c, 5)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	closings := helper.Duplicate(asset.SnapshotsAsClosings(snapshots[2]), 4)
+	highs := asset.SnapshotsAsHighs(snapshots[3])
+	lows := asset.SnapshotsAsLows(snapshots[4])
+
+	conversionLine, baseLine, leadingSpanA, leadingSpanB, laggingLine := i.IchimokuCloud.Compute(highs, lows, closings[1])
+
+	conversionLine = helper.Shift(conversionLine, i.IchimokuCloud.IdlePeriod(), 0)
+	baseLine = helper.Shift(baseLine, i.IchimokuCloud.IdlePeriod(), 0)
+	// The leading spans are displaced forward by the base period, and the values beyond
+	// the last closing are dropped.
+	displacement := i.IchimokuCloud.IdlePeriod() + i.IchimokuCloud.BaseMax.Period
+
+	leadingSpanA = helper.Operate(closings[2], helper.Shift(leadingSpanA, displacement, 0), func(_, spanA float64) float64 {
+		return spanA
+	})
+
+	leadingSpanB = helper.Operate(closings[3], helper.Shift(leadingSpanB, displacement, 0), func(_, spanB float64) float64 {
+		return spanB
+	})
+
+	leadingSpanASplice := helper.Duplicate(leadingSpanA, 2)
+	leadingSpanBSplice := helper.Duplicate(leadingSpanB, 2)
+	laggingLine = helper.Shift(laggingLine, i.IchimokuCloud.IdlePeriod(), 0)
+
+	actions, outcomes := strategy.ComputeWithOutcome(i, snapshots[1])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(i.Name(), dates)
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings[0]))
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations))
+	report.AddColumn(helper.NewNumericReportColumn("Conversion Line", conversionLine))
+	report.AddColumn(helper.NewNumericReportColumn("Base Line", baseLine))
+	report.AddColumn(helper.NewNumericReportColumn("Leading Span A", leadingSpanASplice[0]))
+
+	// The cloud is shaded between the leading spans.
+	report.AddColumn(helper.NewIntervalReportColumn("Leading Span A", leadingSpanASplice[1]))
+	report.AddColumn(helper.NewIntervalReportColumn("Leading Span B", leadingSpanBSplice[0]))
+
+	report.AddColumn(helper.NewNumericReportColumn("Leading Span B", leadingSpanBSplice[1]))
+	report.AddColumn(helper.NewNumericReportColumn("Lagging Line", laggingLine))
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 1)
+
+	return report
+}
@@ -24,8 +24,10 @@ import "github.com/miromax42/indicator/v2/strategy"
 func AllStrategies() []strategy.Strategy {
 	return []strategy.Strategy{
 		NewAwesomeOscillatorStrategy(),
+		NewIchimokuCloudStrategy(),
 		NewRsiStrategy(),
 		NewStochasticRsiStrategy(),
 		NewTripleRsiStrategy(),
+		NewWilliamsRStrategy(),
 	}
 }
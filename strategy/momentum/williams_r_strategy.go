@@ -0,0 +1,127 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package momentum
+
+import (
+	"fmt"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/momentum"
+	"github.com/miromax42/indicator/v2/strategy"
+)
+
+const (
+	// DefaultWilliamsRStrategyBuyAt defines the default Williams %R level at which a Buy action is generated.
+	DefaultWilliamsRStrategyBuyAt = -80
+
+	// DefaultWilliamsRStrategySellAt defines the default Williams %R level at which a Sell action is generated.
+	DefaultWilliamsRStrategySellAt = -20
+)
+
+// WilliamsRStrategy represents the configuration parameters for calculating the Williams %R strategy.
+// A Williams %R value at or below the buy level suggests an oversold state and recommends a Buy action,
+// while a value at or above the sell level suggests an overbought state and recommends a Sell action.
+type WilliamsRStrategy struct {
+	// WilliamsR represents the configuration parameters for calculating the Williams %R.
+	WilliamsR *momentum.WilliamsR[float64]
+
+	// BuyAt defines the Williams %R level at which a Buy action is generated.
+	BuyAt float64
+
+	// SellAt defines the Williams %R level at which a Sell action is generated.
+	SellAt float64
+}
+
+// NewWilliamsRStrategy function initializes a new Williams %R strategy instance with the default parameters.
+func NewWilliamsRStrategy() *WilliamsRStrategy {
+	return NewWilliamsRStrategyWith(
+		DefaultWilliamsRStrategyBuyAt,
+		DefaultWilliamsRStrategySellAt,
+	)
+}
+
+// NewWilliamsRStrategyWith function initializes a new Williams %R strategy instance with the given parameters.
+func NewWilliamsRStrategyWith(buyAt, sellAt float64) *WilliamsRStrategy {
+	return &WilliamsRStrategy{
+		WilliamsR: momentum.NewWilliamsR[float64](),
+		BuyAt:     buyAt,
+		SellAt:    sellAt,
+	}
+}
+
+// Name returns the name of the strategy.
+func (w *WilliamsRStrategy) Name() string {
+	return fmt.Sprintf("Williams R Strategy %.0f-%.0f", w.BuyAt, w.SellAt)
+}
+
+// Compute processes the provided asset snapshots and generates a stream of actionable recommendations.
+func (w *WilliamsRStrategy) Compute(c <-chan *asset.Snapshot) <-chan strategy.Action {
+	snapshots := helper.Duplicate(c, 3)
+
+	highs := asset.SnapshotsAsHighs(snapshots[0])
+	lows := asset.SnapshotsAsLows(snapshots[1])
+	closings := asset.SnapshotsAsClosings(snapshots[2])
+
+	wrs := w.WilliamsR.Compute(highs, lows, closings)
+
+	actions := helper.Map(wrs, func(wr float64) strategy.Action {
+		if wr <= w.BuyAt {
+			return strategy.Buy
+		}
+
+		if wr >= w.SellAt {
+			return strategy.Sell
+		}
+
+		return strategy.Hold
+	})
+
+	// Williams %R starts only after the idle period.
+	actions = helper.Shift(actions, w.WilliamsR.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
+
+// Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
+func (w *WilliamsRStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> highs       |
+	// snapshots[2] -> lows        | -> wrs
+	// snapshots[3] -> closings[0] |
+	//                 closings[1] -> closings
+	// snapshots[4] -> actions     -> annotations
+	//              -> outcomes
+	//
+	snapshots := helper.Duplicate(c, 5)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	highs := asset.SnapshotsAsHighs(snapshots[1])
+	lows := asset.SnapshotsAsLows(snapshots[2])
+	closingsSplice := helper.Duplicate(asset.SnapshotsAsClosings(snapshots[3]), 2)
+
+	wrs := helper.Shift(
+		w.WilliamsR.Compute(highs, lows, closingsSplice[0]),
+		w.WilliamsR.IdlePeriod(),
+		0,
+	)
+
+	actions, outcomes := strategy.ComputeWithOutcome(w, snapshots[4])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(w.Name(), dates)
+	report.AddChart()
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closingsSplice[1]))
+	report.AddColumn(helper.NewNumericReportColumn("Williams %R", wrs), 1)
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations), 0, 1)
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 2)
+
+	return report
+}
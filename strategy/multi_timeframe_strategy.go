@@ -0,0 +1,197 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package strategy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+)
+
+// MultiTimeframeRule combines the action of the base strategy with the biases of the higher
+// timeframe strategies into a single action. The bias of a higher timeframe is the last
+// action other than Hold recommended by its strategy.
+type MultiTimeframeRule func(base Action, biases []Action) Action
+
+// MultiTimeframeConfirmRule recommends the action of the base strategy only when the biases of
+// all higher timeframes are the same action, such as buying only in weekly uptrends and selling
+// only in weekly downtrends.
+func MultiTimeframeConfirmRule(base Action, biases []Action) Action {
+	for _, bias := range biases {
+		if bias != base {
+			return Hold
+		}
+	}
+
+	return base
+}
+
+// MultiTimeframeBuyFilterRule recommends the Buy action of the base strategy only when the
+// biases of all higher timeframes are Buy. The remaining actions of the base strategy are
+// recommended regardless of the biases, so the positions can always be exited.
+func MultiTimeframeBuyFilterRule(base Action, biases []Action) Action {
+	if base != Buy {
+		return base
+	}
+
+	return MultiTimeframeConfirmRule(base, biases)
+}
+
+// HigherTimeframe is a strategy that runs on the snapshots resampled into a higher timeframe.
+type HigherTimeframe struct {
+	// Resampler is the resampler for the higher timeframe.
+	Resampler *asset.Resampler
+
+	// Strategy is the strategy for the higher timeframe.
+	Strategy Strategy
+}
+
+// NewHigherTimeframe function initializes a new higher timeframe with the given resampler and strategy.
+func NewHigherTimeframe(resampler *asset.Resampler, strategy Strategy) *HigherTimeframe {
+	return &HigherTimeframe{
+		Resampler: resampler,
+		Strategy:  strategy,
+	}
+}
+
+// MultiTimeframeStrategy runs the base strategy on the incoming snapshots, and the higher
+// timeframe strategies on the snapshots resampled into their timeframes, such as taking
+// the daily RSI buys only when the weekly MACD is bullish. The higher timeframe actions
+// are aligned back to the base snapshots when their bars are completed, which is by the
+// first snapshot of the next period, hence they never look ahead.
+//
+// Example:
+//
+//	mtf := strategy.NewMultiTimeframeStrategy(
+//		momentum.NewRsiStrategy(),
+//		strategy.NewHigherTimeframe(asset.NewResampler(asset.OneWeek), trend.NewMacdStrategy()),
+//	)
+type MultiTimeframeStrategy struct {
+	// BaseStrategy is the strategy for the incoming snapshots.
+	BaseStrategy Strategy
+
+	// Timeframes are the higher timeframes.
+	Timeframes []*HigherTimeframe
+
+	// Rule combines the base action with the higher timeframe biases.
+	Rule MultiTimeframeRule
+}
+
+// NewMultiTimeframeStrategy function initializes a new multi timeframe strategy instance with
+// the given base strategy and higher timeframes, combined using the confirm rule.
+func NewMultiTimeframeStrategy(baseStrategy Strategy, timeframes ...*HigherTimeframe) *MultiTimeframeStrategy {
+	return NewMultiTimeframeStrategyWith(MultiTimeframeConfirmRule, baseStrategy, timeframes...)
+}
+
+// NewMultiTimeframeStrategyWith function initializes a new multi timeframe strategy instance with
+// the given rule, base strategy, and higher timeframes.
+func NewMultiTimeframeStrategyWith(rule MultiTimeframeRule, baseStrategy Strategy, timeframes ...*HigherTimeframe) *MultiTimeframeStrategy {
+	return &MultiTimeframeStrategy{
+		BaseStrategy: baseStrategy,
+		Timeframes:   timeframes,
+		Rule:         rule,
+	}
+}
+
+// Name returns the name of the strategy.
+func (m *MultiTimeframeStrategy) Name() string {
+	names := []string{m.BaseStrategy.Name()}
+
+	for _, timeframe := range m.Timeframes {
+		names = append(names, fmt.Sprintf("%s %s", timeframe.Resampler.Timeframe(), timeframe.Strategy.Name()))
+	}
+
+	return fmt.Sprintf("MTF(%s)", strings.Join(names, ","))
+}
+
+// Compute processes the provided asset snapshots and generates a stream of actionable recommendations.
+func (m *MultiTimeframeStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan Action {
+	snapshotsSplice := helper.Duplicate(snapshots, len(m.Timeframes)+1)
+
+	base := m.BaseStrategy.Compute(snapshotsSplice[0])
+	biases := make([]<-chan Action, len(m.Timeframes))
+
+	for i, timeframe := range m.Timeframes {
+		biases[i] = timeframe.Biases(snapshotsSplice[i+1])
+	}
+
+	result := make(chan Action)
+
+	go func() {
+		defer close(result)
+
+		for action := range base {
+			current := make([]Action, len(biases))
+
+			for i, bias := range biases {
+				current[i] = <-bias
+			}
+
+			result <- m.Rule(action, current)
+		}
+
+		for _, bias := range biases {
+			helper.Drain(bias)
+		}
+	}()
+
+	return result
+}
+
+// Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
+func (m *MultiTimeframeStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> closings
+	// snapshots[2] -> Compute -> actions -> annotations
+	// snapshots[3...] -> Biases -> biases
+	//
+	snapshots := helper.Duplicate(c, len(m.Timeframes)+3)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	closings := asset.SnapshotsAsClosings(snapshots[1])
+
+	actions, outcomes := ComputeWithOutcome(m, snapshots[2])
+	annotations := ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(m.Name(), dates)
+	report.AddChart()
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings))
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations))
+
+	for i, timeframe := range m.Timeframes {
+		biases := helper.Map(timeframe.Biases(snapshots[i+3]), func(action Action) float64 {
+			return float64(action)
+		})
+
+		name := fmt.Sprintf("%s %s", timeframe.Resampler.Timeframe(), timeframe.Strategy.Name())
+		report.AddColumn(helper.NewStepReportColumn(name, biases), 1)
+	}
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 2)
+
+	return report
+}
+
+// Biases processes the provided asset snapshots, and generates for each one of them the last
+// action other than Hold recommended by the strategy on the completed higher timeframe bars.
+func (h *HigherTimeframe) Biases(snapshots <-chan *asset.Snapshot) <-chan Action {
+	bias := Hold
+
+	return helper.Map(ComputeTransformed(h.Strategy, h.Resampler, snapshots), func(actions []Action) Action {
+		for _, action := range actions {
+			if action != Hold {
+				bias = action
+			}
+		}
+
+		return bias
+	})
+}
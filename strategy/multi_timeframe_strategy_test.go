@@ -0,0 +1,116 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package strategy_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/strategy"
+	"github.com/miromax42/indicator/v2/strategy/momentum"
+	"github.com/miromax42/indicator/v2/strategy/trend"
+	"github.com/miromax42/indicator/v2/strategy/volatility"
+)
+
+func newTestMultiTimeframeStrategy() *strategy.MultiTimeframeStrategy {
+	return strategy.NewMultiTimeframeStrategyWith(
+		strategy.MultiTimeframeBuyFilterRule,
+		momentum.NewRsiStrategyWith(40, 60),
+		strategy.NewHigherTimeframe(asset.NewResampler(asset.OneWeek), trend.NewMacdStrategyWith(2, 4, 2)),
+	)
+}
+
+func TestMultiTimeframeStrategy(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/repository/brk-b.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := helper.ReadFromCsvFile[strategy.Result]("testdata/multi_timeframe.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := helper.Map(results, func(r *strategy.Result) strategy.Action { return r.Action })
+
+	actual := newTestMultiTimeframeStrategy().Compute(snapshots)
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMultiTimeframeStrategyReport(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/repository/brk-b.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report := newTestMultiTimeframeStrategy().Report(snapshots)
+
+	fileName := "multi_timeframe.html"
+	defer os.Remove(fileName)
+
+	err = report.WriteToFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMultiTimeframeStrategyName(t *testing.T) {
+	expected := "MTF(RSI Strategy 40-60,1w MACD Strategy (2,4,2))"
+
+	actual := newTestMultiTimeframeStrategy().Name()
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestMultiTimeframeRules(t *testing.T) {
+	tests := []struct {
+		rule     strategy.MultiTimeframeRule
+		base     strategy.Action
+		biases   []strategy.Action
+		expected strategy.Action
+	}{
+		{strategy.MultiTimeframeConfirmRule, strategy.Buy, []strategy.Action{strategy.Buy, strategy.Buy}, strategy.Buy},
+		{strategy.MultiTimeframeConfirmRule, strategy.Buy, []strategy.Action{strategy.Buy, strategy.Sell}, strategy.Hold},
+		{strategy.MultiTimeframeConfirmRule, strategy.Sell, []strategy.Action{strategy.Buy}, strategy.Hold},
+		{strategy.MultiTimeframeConfirmRule, strategy.Sell, []strategy.Action{strategy.Sell}, strategy.Sell},
+		{strategy.MultiTimeframeBuyFilterRule, strategy.Buy, []strategy.Action{strategy.Hold}, strategy.Hold},
+		{strategy.MultiTimeframeBuyFilterRule, strategy.Buy, []strategy.Action{strategy.Buy}, strategy.Buy},
+		{strategy.MultiTimeframeBuyFilterRule, strategy.Sell, []strategy.Action{strategy.Buy}, strategy.Sell},
+	}
+
+	for _, test := range tests {
+		actual := test.rule(test.base, test.biases)
+		if actual != test.expected {
+			t.Fatalf("actual %v expected %v", actual, test.expected)
+		}
+	}
+}
+
+func TestMultiTimeframeStrategyReadAhead(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/repository/brk-b.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshotsSlice := helper.ChanToSlice(snapshots)
+
+	// Super Trend reads ahead of its actions.
+	multiTimeframe := strategy.NewMultiTimeframeStrategy(
+		momentum.NewRsiStrategy(),
+		strategy.NewHigherTimeframe(asset.NewResampler(asset.OneWeek), volatility.NewSuperTrendStrategy()),
+	)
+
+	actual := len(helper.ChanToSlice(multiTimeframe.Compute(helper.SliceToChan(snapshotsSlice))))
+	if actual != len(snapshotsSlice) {
+		t.Fatalf("actual %v expected %v", actual, len(snapshotsSlice))
+	}
+}
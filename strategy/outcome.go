@@ -7,6 +7,8 @@ package strategy
 import "github.com/miromax42/indicator/v2/helper"
 
 // Outcome simulates the potential result of executing the given actions based on the provided values.
+// A Buy enters a long position and a Short enters a short position of a single unit of capital,
+// exiting the opposite position first, and a Sell exits the current position.
 //func Outcome[T helper.Number](values <-chan T, actions <-chan Action) <-chan float64 {
 //	balance := 1.0
 //	shares := 0.0
@@ -27,17 +29,25 @@ import "github.com/miromax42/indicator/v2/helper"
 func Outcome[T helper.Number](values <-chan T, actions <-chan Action) <-chan float64 {
 	balance := 0.
 	shares := 0.
-	var bought bool
+
+	// No position is held initially.
+	position := Sell
 
 	return helper.Operate(values, actions, func(value T, action Action) float64 {
-		if action == Buy && !bought {
-			shares = 1 / float64(value)
-			balance -= 1
-			bought = true
-		} else if bought && action == Sell {
+		if action != Hold && action != position {
+			// Exit the current long or short position.
 			balance += shares * float64(value)
 			shares = 0
-			bought = false
+
+			if action == Buy {
+				shares = 1 / float64(value)
+				balance -= 1
+			} else if action == Short {
+				shares = -1 / float64(value)
+				balance += 1
+			}
+
+			position = action
 		}
 
 		return balance + (shares * float64(value))
@@ -34,3 +34,25 @@ func TestOutcome(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestOutcomeShort(t *testing.T) {
+	values := helper.SliceToChan([]float64{
+		10, 8, 5, 10, 12,
+	})
+
+	actions := helper.SliceToChan([]strategy.Action{
+		strategy.Short, strategy.Hold, strategy.Buy, strategy.Hold, strategy.Sell,
+	})
+
+	// The short position gains 0.5 and the long position gains 1.4.
+	expected := helper.SliceToChan([]float64{
+		0, 0.2, 0.5, 1.5, 1.9,
+	})
+
+	actual := helper.RoundDigits(strategy.Outcome(values, actions), 2)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
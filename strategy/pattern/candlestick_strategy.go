@@ -0,0 +1,143 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package pattern
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/pattern"
+	"github.com/miromax42/indicator/v2/strategy"
+)
+
+// CandlestickStrategy represents the configuration parameters for calculating the Candlestick strategy.
+// It converts the signals of the given candlestick patterns into actions. Each detected Bullish pattern
+// is a vote for buying, and each detected Bearish pattern is a vote for selling. A Buy action is recommended
+// when the bullish votes outnumber the bearish votes, and a Sell action is recommended when the bearish
+// votes outnumber the bullish votes. Neutral patterns are only annotated in the report.
+type CandlestickStrategy struct {
+	// Patterns are the candlestick patterns to detect.
+	Patterns []pattern.Pattern[float64]
+}
+
+// NewCandlestickStrategy function initializes a new Candlestick strategy instance with all the patterns.
+func NewCandlestickStrategy() *CandlestickStrategy {
+	return NewCandlestickStrategyWith(pattern.AllPatterns[float64]()...)
+}
+
+// NewCandlestickStrategyWith function initializes a new Candlestick strategy instance with the given patterns.
+func NewCandlestickStrategyWith(patterns ...pattern.Pattern[float64]) *CandlestickStrategy {
+	return &CandlestickStrategy{
+		Patterns: patterns,
+	}
+}
+
+// Name returns the name of the strategy.
+func (c *CandlestickStrategy) Name() string {
+	names := make([]string, len(c.Patterns))
+	for i, p := range c.Patterns {
+		names[i] = p.Name()
+	}
+
+	return fmt.Sprintf("Candlestick Strategy (%s)", strings.Join(names, ", "))
+}
+
+// Compute processes the provided asset snapshots and generates a stream of actionable recommendations.
+func (c *CandlestickStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	snapshotsSplice := helper.Duplicate(snapshots, 2)
+
+	votes := helper.Map(snapshotsSplice[0], func(*asset.Snapshot) float64 { return 0 })
+
+	for _, signals := range c.computeSignals(snapshotsSplice[1]) {
+		votes = helper.Add(votes, helper.Map(signals, func(signal pattern.Signal) float64 {
+			if signal.IsDirectional() {
+				return float64(signal)
+			}
+
+			return 0
+		}))
+	}
+
+	return helper.Map(votes, func(vote float64) strategy.Action {
+		if vote > 0 {
+			return strategy.Buy
+		}
+
+		if vote < 0 {
+			return strategy.Sell
+		}
+
+		return strategy.Hold
+	})
+}
+
+// Report processes the provided asset snapshots and generates a report annotated with the recommended actions
+// and the detected patterns.
+func (c *CandlestickStrategy) Report(snapshots <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> Compute  -> actions -> annotations
+	// snapshots[2] -> closings
+	// snapshots[3] -> names
+	// snapshots[4] -> computeSignals -> names
+	//
+	snapshotsSplice := helper.Duplicate(snapshots, 5)
+
+	dates := asset.SnapshotsAsDates(snapshotsSplice[0])
+	closings := asset.SnapshotsAsClosings(snapshotsSplice[2])
+	names := helper.Map(snapshotsSplice[3], func(*asset.Snapshot) string { return "" })
+
+	for i, signals := range c.computeSignals(snapshotsSplice[4]) {
+		name := c.Patterns[i].Name()
+
+		names = helper.Operate(names, signals, func(names string, signal pattern.Signal) string {
+			if signal == pattern.None {
+				return names
+			}
+
+			if names == "" {
+				return name
+			}
+
+			return names + ", " + name
+		})
+	}
+
+	actions, outcomes := strategy.ComputeWithOutcome(c, snapshotsSplice[1])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(c.Name(), dates)
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings))
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations))
+	report.AddColumn(helper.NewAnnotationReportColumn(names))
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 1)
+
+	return report
+}
+
+// computeSignals computes the signals of each pattern aligned with the snapshots.
+func (c *CandlestickStrategy) computeSignals(snapshots <-chan *asset.Snapshot) []<-chan pattern.Signal {
+	snapshotsSplice := helper.Duplicate(snapshots, 4)
+
+	openings := helper.Duplicate(asset.SnapshotsAsOpenings(snapshotsSplice[0]), len(c.Patterns))
+	highs := helper.Duplicate(asset.SnapshotsAsHighs(snapshotsSplice[1]), len(c.Patterns))
+	lows := helper.Duplicate(asset.SnapshotsAsLows(snapshotsSplice[2]), len(c.Patterns))
+	closings := helper.Duplicate(asset.SnapshotsAsClosings(snapshotsSplice[3]), len(c.Patterns))
+
+	signals := make([]<-chan pattern.Signal, len(c.Patterns))
+
+	for i, p := range c.Patterns {
+		// Patterns start only after their idle periods.
+		signals[i] = helper.Shift(p.Compute(openings[i], highs[i], lows[i], closings[i]), p.IdlePeriod(), pattern.None)
+	}
+
+	return signals
+}
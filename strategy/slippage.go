@@ -0,0 +1,130 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package strategy
+
+import (
+	"fmt"
+
+	"github.com/miromax42/indicator/v2/asset"
+)
+
+const (
+	// DefaultFixedSlippageBps is the default slippage in basis points of the price.
+	DefaultFixedSlippageBps = 5.0
+
+	// DefaultRangeSlippageFraction is the default fraction of the High-Low range slipped.
+	DefaultRangeSlippageFraction = 0.1
+
+	// DefaultVolumeSlippageImpact is the default price impact of trading the entire volume.
+	DefaultVolumeSlippageImpact = 0.1
+)
+
+// Slippage defines the difference between the closing price and the price a
+// trade is actually executed at.
+type Slippage interface {
+	// Slippage returns the unfavorable price difference per share for trading
+	// the given number of shares at the given snapshot. It is added to the
+	// price when buying, and subtracted from the price when selling.
+	Slippage(snapshot *asset.Snapshot, shares float64) float64
+
+	// String is the string representation of the slippage.
+	String() string
+}
+
+// FixedSlippage slips the price by a fixed number of basis points.
+//
+//	Slippage = Close * Bps / 10000
+type FixedSlippage struct {
+	// Bps is the slippage in basis points of the price.
+	Bps float64
+}
+
+// NewFixedSlippage function initializes a new fixed slippage instance with the default parameters.
+func NewFixedSlippage() *FixedSlippage {
+	return NewFixedSlippageWith(DefaultFixedSlippageBps)
+}
+
+// NewFixedSlippageWith function initializes a new fixed slippage instance with the given parameters.
+func NewFixedSlippageWith(bps float64) *FixedSlippage {
+	return &FixedSlippage{
+		Bps: bps,
+	}
+}
+
+// Slippage returns the price difference per share.
+func (f *FixedSlippage) Slippage(snapshot *asset.Snapshot, _ float64) float64 {
+	return snapshot.Close * f.Bps / 10000
+}
+
+// String is the string representation of the fixed slippage.
+func (f *FixedSlippage) String() string {
+	return fmt.Sprintf("Fixed Slippage(%g bps)", f.Bps)
+}
+
+// RangeSlippage slips the price by a fraction of the High-Low range of the snapshot.
+//
+//	Slippage = (High - Low) * Fraction
+type RangeSlippage struct {
+	// Fraction is the fraction of the range slipped.
+	Fraction float64
+}
+
+// NewRangeSlippage function initializes a new range slippage instance with the default parameters.
+func NewRangeSlippage() *RangeSlippage {
+	return NewRangeSlippageWith(DefaultRangeSlippageFraction)
+}
+
+// NewRangeSlippageWith function initializes a new range slippage instance with the given parameters.
+func NewRangeSlippageWith(fraction float64) *RangeSlippage {
+	return &RangeSlippage{
+		Fraction: fraction,
+	}
+}
+
+// Slippage returns the price difference per share.
+func (r *RangeSlippage) Slippage(snapshot *asset.Snapshot, _ float64) float64 {
+	return (snapshot.High - snapshot.Low) * r.Fraction
+}
+
+// String is the string representation of the range slippage.
+func (r *RangeSlippage) String() string {
+	return fmt.Sprintf("Range Slippage(%g)", r.Fraction)
+}
+
+// VolumeSlippage slips the price in proportion to the participation of the trade in
+// the volume of the snapshot. Trading the entire volume moves the price by the impact
+// fraction. Snapshots without volume do not slip.
+//
+//	Slippage = Close * Impact * Shares / Volume
+type VolumeSlippage struct {
+	// Impact is the price impact, as a fraction of the price, of trading the entire volume.
+	Impact float64
+}
+
+// NewVolumeSlippage function initializes a new volume slippage instance with the default parameters.
+func NewVolumeSlippage() *VolumeSlippage {
+	return NewVolumeSlippageWith(DefaultVolumeSlippageImpact)
+}
+
+// NewVolumeSlippageWith function initializes a new volume slippage instance with the given parameters.
+func NewVolumeSlippageWith(impact float64) *VolumeSlippage {
+	return &VolumeSlippage{
+		Impact: impact,
+	}
+}
+
+// Slippage returns the price difference per share.
+func (v *VolumeSlippage) Slippage(snapshot *asset.Snapshot, shares float64) float64 {
+	if snapshot.Volume <= 0 {
+		return 0
+	}
+
+	return snapshot.Close * v.Impact * shares / snapshot.Volume
+}
+
+// String is the string representation of the volume slippage.
+func (v *VolumeSlippage) String() string {
+	return fmt.Sprintf("Volume Slippage(%g)", v.Impact)
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package strategy_test
+
+import (
+	"testing"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/strategy"
+)
+
+func TestFixedSlippage(t *testing.T) {
+	slippage := strategy.NewFixedSlippageWith(10)
+
+	actual := slippage.Slippage(&asset.Snapshot{Close: 200}, 1)
+	if actual != 0.2 {
+		t.Fatalf("actual %v expected %v", actual, 0.2)
+	}
+}
+
+func TestRangeSlippage(t *testing.T) {
+	slippage := strategy.NewRangeSlippageWith(0.25)
+
+	actual := slippage.Slippage(&asset.Snapshot{High: 12, Low: 8, Close: 10}, 1)
+	if actual != 1 {
+		t.Fatalf("actual %v expected %v", actual, 1)
+	}
+}
+
+func TestVolumeSlippage(t *testing.T) {
+	slippage := strategy.NewVolumeSlippageWith(0.5)
+
+	actual := slippage.Slippage(&asset.Snapshot{Close: 10, Volume: 1000}, 100)
+	if actual != 0.5 {
+		t.Fatalf("actual %v expected %v", actual, 0.5)
+	}
+
+	actual = slippage.Slippage(&asset.Snapshot{Close: 10}, 100)
+	if actual != 0 {
+		t.Fatalf("actual %v expected %v", actual, 0)
+	}
+}
+
+func TestSlippageString(t *testing.T) {
+	slippages := []strategy.Slippage{
+		strategy.NewFixedSlippage(),
+		strategy.NewRangeSlippage(),
+		strategy.NewVolumeSlippage(),
+	}
+
+	expected := []string{
+		"Fixed Slippage(5 bps)",
+		"Range Slippage(0.1)",
+		"Volume Slippage(0.1)",
+	}
+
+	for i, slippage := range slippages {
+		if slippage.String() != expected[i] {
+			t.Fatalf("actual %v expected %v", slippage.String(), expected[i])
+		}
+	}
+}
@@ -50,6 +50,18 @@ func ComputeWithOutcome(s Strategy, c <-chan *asset.Snapshot) (<-chan Action, <-
 	return actions[0], outcomes
 }
 
+// ComputeWithOutcomeAndCosts uses the given strategy to processes the provided asset snapshots
+// and generates a stream of actionable recommendations, outcomes after the given transaction
+// costs, and the cumulative transaction costs. The three channels must be consumed concurrently.
+func ComputeWithOutcomeAndCosts(s Strategy, c <-chan *asset.Snapshot, costs *Costs) (<-chan Action, <-chan float64, <-chan float64) {
+	snapshots := helper.Duplicate(c, 2)
+
+	actions := helper.Duplicate(s.Compute(snapshots[0]), 2)
+	outcomes, totals := OutcomeWithCosts(snapshots[1], actions[1], costs)
+
+	return actions[0], outcomes, totals
+}
+
 // AllStrategies returns a slice containing references to all available base strategies.
 func AllStrategies() []Strategy {
 	return []Strategy{
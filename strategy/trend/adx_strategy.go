@@ -0,0 +1,146 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"fmt"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/strategy"
+	"github.com/miromax42/indicator/v2/trend"
+)
+
+const (
+	// DefaultAdxStrategyThreshold is the default ADX level above which the trend is considered strong.
+	DefaultAdxStrategyThreshold = 25
+)
+
+// AdxStrategy represents the configuration parameters for calculating the ADX strategy. It trades
+// the crossovers of the directional indicators only when the ADX is above the threshold, suggesting
+// a strong trend. A +DI crossing above the -DI recommends a Buy action, while a -DI crossing above
+// the +DI recommends a Sell action.
+type AdxStrategy struct {
+	// Adx represents the configuration parameters for calculating the ADX.
+	Adx *trend.Adx[float64]
+
+	// Threshold is the ADX level above which the crossovers are traded.
+	Threshold float64
+}
+
+// NewAdxStrategy function initializes a new ADX strategy instance with the default parameters.
+func NewAdxStrategy() *AdxStrategy {
+	return NewAdxStrategyWith(trend.DefaultAdxPeriod, DefaultAdxStrategyThreshold)
+}
+
+// NewAdxStrategyWith function initializes a new ADX strategy instance with the given period and threshold.
+func NewAdxStrategyWith(period int, threshold float64) *AdxStrategy {
+	return &AdxStrategy{
+		Adx:       trend.NewAdxWithPeriod[float64](period),
+		Threshold: threshold,
+	}
+}
+
+// Name returns the name of the strategy.
+func (a *AdxStrategy) Name() string {
+	return fmt.Sprintf("ADX Strategy (%d, %.0f)", a.Adx.Dmi.Period, a.Threshold)
+}
+
+// Compute processes the provided asset snapshots and generates a stream of actionable recommendations.
+func (a *AdxStrategy) Compute(c <-chan *asset.Snapshot) <-chan strategy.Action {
+	snapshots := helper.Duplicate(c, 3)
+
+	highs := asset.SnapshotsAsHighs(snapshots[0])
+	lows := asset.SnapshotsAsLows(snapshots[1])
+	closings := asset.SnapshotsAsClosings(snapshots[2])
+
+	plusDis, minusDis, adxs := a.compute(highs, lows, closings)
+
+	first := true
+	var previousPlusDi, previousMinusDi float64
+
+	actions := helper.Operate3(plusDis, minusDis, adxs, func(plusDi, minusDi, adx float64) strategy.Action {
+		action := strategy.Hold
+
+		if !first && adx > a.Threshold {
+			if previousPlusDi <= previousMinusDi && plusDi > minusDi {
+				action = strategy.Buy
+			} else if previousMinusDi <= previousPlusDi && minusDi > plusDi {
+				action = strategy.Sell
+			}
+		}
+
+		first = false
+		previousPlusDi = plusDi
+		previousMinusDi = minusDi
+
+		return action
+	})
+
+	// ADX starts only after the idle period.
+	actions = helper.Shift(actions, a.Adx.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
+
+// Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
+func (a *AdxStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> highs       |
+	// snapshots[2] -> lows        | -> plusDis, minusDis, adxs
+	// snapshots[3] -> closings[0] |
+	//                 closings[1] -> closings
+	//                 closings[2] -> threshold
+	// snapshots[4] -> actions     -> annotations
+	//              -> outcomes
+	//
+	snapshots := helper.Duplicate(c, 5)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	highs := asset.SnapshotsAsHighs(snapshots[1])
+	lows := asset.SnapshotsAsLows(snapshots[2])
+	closingsSplice := helper.Duplicate(asset.SnapshotsAsClosings(snapshots[3]), 3)
+
+	plusDis, minusDis, adxs := a.compute(highs, lows, closingsSplice[0])
+	plusDis = helper.Shift(plusDis, a.Adx.IdlePeriod(), 0)
+	minusDis = helper.Shift(minusDis, a.Adx.IdlePeriod(), 0)
+	adxs = helper.Shift(adxs, a.Adx.IdlePeriod(), 0)
+	threshold := helper.Map(closingsSplice[2], func(float64) float64 { return a.Threshold })
+
+	actions, outcomes := strategy.ComputeWithOutcome(a, snapshots[4])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(a.Name(), dates)
+	report.AddChart()
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closingsSplice[1]))
+	report.AddColumn(helper.NewNumericReportColumn("+DI", plusDis), 1)
+	report.AddColumn(helper.NewNumericReportColumn("-DI", minusDis), 1)
+	report.AddColumn(helper.NewNumericReportColumn("ADX", adxs), 1)
+	report.AddColumn(helper.NewNumericReportColumn("Threshold", threshold), 1)
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations), 0, 1)
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 2)
+
+	return report
+}
+
+// compute computes the directional indicators and the ADX aligned to start together.
+func (a *AdxStrategy) compute(highs, lows, closings <-chan float64) (<-chan float64, <-chan float64, <-chan float64) {
+	plusDis, minusDis := a.Adx.Dmi.Compute(highs, lows, closings)
+
+	plusDisSplice := helper.Duplicate(plusDis, 2)
+	minusDisSplice := helper.Duplicate(minusDis, 2)
+
+	adxs := a.Adx.ComputeWithDi(plusDisSplice[1], minusDisSplice[1])
+
+	plusDisSplice[0] = helper.Skip(plusDisSplice[0], a.Adx.Rma.IdlePeriod())
+	minusDisSplice[0] = helper.Skip(minusDisSplice[0], a.Adx.Rma.IdlePeriod())
+
+	return plusDisSplice[0], minusDisSplice[0], adxs
+}
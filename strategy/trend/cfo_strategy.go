@@ -0,0 +1,108 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"fmt"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/strategy"
+	"github.com/miromax42/indicator/v2/trend"
+)
+
+// CfoStrategy represents the configuration parameters for calculating the Chande Forecast
+// Oscillator (CFO) strategy. A CFO value crossing above zero suggests that the price moved
+// above its forecast and a bullish trend, while crossing below zero suggests that the price
+// moved below its forecast and a bearish trend.
+type CfoStrategy struct {
+	// Cfo represents the configuration parameters for calculating the CFO.
+	Cfo *trend.Cfo[float64]
+}
+
+// NewCfoStrategy function initializes a new CFO strategy instance with the default parameters.
+func NewCfoStrategy() *CfoStrategy {
+	return NewCfoStrategyWith(trend.DefaultCfoPeriod)
+}
+
+// NewCfoStrategyWith function initializes a new CFO strategy instance with the given period.
+func NewCfoStrategyWith(period int) *CfoStrategy {
+	return &CfoStrategy{
+		Cfo: trend.NewCfoWithPeriod[float64](period),
+	}
+}
+
+// Name returns the name of the strategy.
+func (c *CfoStrategy) Name() string {
+	return fmt.Sprintf("CFO Strategy (%d)", c.Cfo.Mlr.Mls.Sum.Period)
+}
+
+// Compute processes the provided asset snapshots and generates a
+// stream of actionable recommendations.
+func (c *CfoStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	closings := asset.SnapshotsAsClosings(snapshots)
+
+	cfo := c.Cfo.Compute(closings)
+	cfo = helper.Buffered(cfo, 2)
+
+	inputs := helper.Duplicate(cfo, 2)
+
+	// Skip the first value
+	inputs[1] = helper.Skip(inputs[1], 1)
+
+	actions := helper.Operate(inputs[0], inputs[1], func(b, c float64) strategy.Action {
+		// A CFO value crossing above zero suggests a bullish trend.
+		if c >= 0 && b < 0 {
+			return strategy.Buy
+		}
+
+		// A CFO value crossing below zero suggests a bearish trend.
+		if c <= 0 && b > 0 {
+			return strategy.Sell
+		}
+
+		return strategy.Hold
+	})
+
+	// CFO starts only after a full period.
+	actions = helper.Shift(actions, c.Cfo.IdlePeriod()+1, strategy.Hold)
+
+	return actions
+}
+
+// Report processes the provided asset snapshots and generates a
+// report annotated with the recommended actions.
+func (c *CfoStrategy) Report(snapshots <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> Compute     -> actions -> annotations
+	// snapshots[2] -> closings[0] -> close
+	//              -> closings[1] -> Cfo.Compute -> cfo
+	//              -> closings[2] -> zero
+	//
+	snapshotsSplice := helper.Duplicate(snapshots, 3)
+
+	dates := asset.SnapshotsAsDates(snapshotsSplice[0])
+	closings := helper.Duplicate(asset.SnapshotsAsClosings(snapshotsSplice[2]), 3)
+	cfo := helper.Shift(c.Cfo.Compute(closings[1]), c.Cfo.IdlePeriod(), 0)
+	zero := helper.Map(closings[2], func(float64) float64 { return 0 })
+
+	actions, outcomes := strategy.ComputeWithOutcome(c, snapshotsSplice[1])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(c.Name(), dates)
+	report.AddChart()
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings[0]))
+	report.AddColumn(helper.NewNumericReportColumn("CFO", cfo), 1)
+	report.AddColumn(helper.NewNumericReportColumn("Zero", zero), 1)
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations), 0, 1)
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 2)
+
+	return report
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"fmt"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/strategy"
+	"github.com/miromax42/indicator/v2/trend"
+)
+
+// ParabolicSarStrategy represents the configuration parameters for calculating the Parabolic SAR strategy.
+// It recommends a Buy action when the trend flips to rising, as the price crosses above the SAR, and a
+// Sell action when the trend flips to falling, as the price crosses below the SAR.
+type ParabolicSarStrategy struct {
+	// ParabolicSar represents the configuration parameters for calculating the Parabolic SAR.
+	ParabolicSar *trend.ParabolicSar[float64]
+}
+
+// NewParabolicSarStrategy function initializes a new Parabolic SAR strategy instance with the default parameters.
+func NewParabolicSarStrategy() *ParabolicSarStrategy {
+	return NewParabolicSarStrategyWith(trend.DefaultParabolicSarStep, trend.DefaultParabolicSarMax)
+}
+
+// NewParabolicSarStrategyWith function initializes a new Parabolic SAR strategy instance with the given
+// acceleration factor step and maximum.
+func NewParabolicSarStrategyWith(step, max float64) *ParabolicSarStrategy {
+	return &ParabolicSarStrategy{
+		ParabolicSar: trend.NewParabolicSarWith(step, max),
+	}
+}
+
+// Name returns the name of the strategy.
+func (p *ParabolicSarStrategy) Name() string {
+	return fmt.Sprintf("Parabolic SAR Strategy (%.2f, %.2f)", p.ParabolicSar.Step, p.ParabolicSar.Max)
+}
+
+// Compute processes the provided asset snapshots and generates a stream of actionable recommendations.
+func (p *ParabolicSarStrategy) Compute(c <-chan *asset.Snapshot) <-chan strategy.Action {
+	snapshots := helper.Duplicate(c, 3)
+
+	highs := asset.SnapshotsAsHighs(snapshots[0])
+	lows := asset.SnapshotsAsLows(snapshots[1])
+	closings := asset.SnapshotsAsClosings(snapshots[2])
+
+	sars, trends := p.ParabolicSar.Compute(highs, lows, closings)
+	go helper.Drain(sars)
+
+	first := true
+	var previous float64
+
+	actions := helper.Map(trends, func(trend float64) strategy.Action {
+		flipped := !first && trend != previous
+		first = false
+		previous = trend
+
+		if !flipped {
+			return strategy.Hold
+		}
+
+		if trend > 0 {
+			return strategy.Buy
+		}
+
+		return strategy.Sell
+	})
+
+	return actions
+}
+
+// Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
+func (p *ParabolicSarStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> highs       |
+	// snapshots[2] -> lows        | -> sars, trends
+	// snapshots[3] -> closings[0] |
+	//                 closings[1] -> closings
+	// snapshots[4] -> actions     -> annotations
+	//              -> outcomes
+	//
+	snapshots := helper.Duplicate(c, 5)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	highs := asset.SnapshotsAsHighs(snapshots[1])
+	lows := asset.SnapshotsAsLows(snapshots[2])
+	closingsSplice := helper.Duplicate(
+		asset.SnapshotsAsClosings(snapshots[3]),
+		2,
+	)
+
+	sars, trends := p.ParabolicSar.Compute(highs, lows, closingsSplice[0])
+
+	actions, outcomes := strategy.ComputeWithOutcome(p, snapshots[4])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(p.Name(), dates)
+	report.AddChart()
+	report.AddChart()
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closingsSplice[1]))
+	report.AddColumn(helper.NewNumericReportColumn("SAR", sars))
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations))
+
+	report.AddColumn(helper.NewNumericReportColumn("Trend", trends), 1)
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 2)
+
+	return report
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"fmt"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/strategy"
+	"github.com/miromax42/indicator/v2/trend"
+)
+
+// PivotPointStrategy represents the configuration parameters for calculating the pivot point strategy.
+// It trades the bounces and the breakouts of the first support and resistance levels.
+//
+// A closing crossing above R1 is a breakout, and a low reaching S1 with the closing recovering above it
+// from a prior closing at or below it is a bounce, both recommending a Buy action. A closing crossing below
+// S1 is a breakdown, and a high reaching R1 with the closing falling below it from a prior closing at or
+// above it is a rejection, both recommending a Sell action. The bars merely touching the levels are ignored.
+type PivotPointStrategy struct {
+	// PivotPoint represents the configuration parameters for calculating the pivot points.
+	PivotPoint *trend.PivotPoint[float64]
+}
+
+// pivotPointBar is the high, low, and close of a bar.
+type pivotPointBar struct {
+	high  float64
+	low   float64
+	close float64
+}
+
+// NewPivotPointStrategy function initializes a new pivot point strategy instance with the default parameters.
+func NewPivotPointStrategy() *PivotPointStrategy {
+	return NewPivotPointStrategyWith(trend.PivotPointClassic, trend.DefaultPivotPointBars)
+}
+
+// NewPivotPointStrategyWith function initializes a new pivot point strategy instance with the given method
+// and number of bars in a period.
+func NewPivotPointStrategyWith(method trend.PivotPointMethod, bars int) *PivotPointStrategy {
+	return &PivotPointStrategy{
+		PivotPoint: trend.NewPivotPointWith[float64](method, bars),
+	}
+}
+
+// Name returns the name of the strategy.
+func (p *PivotPointStrategy) Name() string {
+	return fmt.Sprintf("Pivot Point Strategy (%s, %d)", p.PivotPoint.Method, p.PivotPoint.Bars)
+}
+
+// Compute processes the provided asset snapshots and generates a stream of actionable recommendations.
+func (p *PivotPointStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	snapshotsSplice := helper.Duplicate(snapshots, 4)
+
+	openings := asset.SnapshotsAsOpenings(snapshotsSplice[0])
+	highs := helper.Duplicate(asset.SnapshotsAsHighs(snapshotsSplice[1]), 2)
+	lows := helper.Duplicate(asset.SnapshotsAsLows(snapshotsSplice[2]), 2)
+	closings := helper.Duplicate(asset.SnapshotsAsClosings(snapshotsSplice[3]), 2)
+
+	pivots, r1s, r2s, r3s, s1s, s2s, s3s := p.PivotPoint.Compute(openings, highs[0], lows[0], closings[0])
+	go helper.Drain(pivots)
+	go helper.Drain(r2s)
+	go helper.Drain(r3s)
+	go helper.Drain(s2s)
+	go helper.Drain(s3s)
+
+	bars := helper.Operate3(
+		helper.Skip(highs[1], p.PivotPoint.IdlePeriod()),
+		helper.Skip(lows[1], p.PivotPoint.IdlePeriod()),
+		helper.Skip(closings[1], p.PivotPoint.IdlePeriod()),
+		func(high, low, closing float64) *pivotPointBar {
+			return &pivotPointBar{
+				high:  high,
+				low:   low,
+				close: closing,
+			}
+		},
+	)
+
+	first := true
+	var previousClosing float64
+
+	actions := helper.Operate3(bars, r1s, s1s, func(bar *pivotPointBar, r1, s1 float64) strategy.Action {
+		action := strategy.Hold
+
+		if !first {
+			breakout := previousClosing <= r1 && bar.close > r1
+			bounce := previousClosing <= s1 && bar.low <= s1 && bar.close > s1
+			breakdown := previousClosing >= s1 && bar.close < s1
+			rejection := previousClosing >= r1 && bar.high >= r1 && bar.close < r1
+
+			buy := breakout || bounce
+			sell := breakdown || rejection
+
+			if buy && !sell {
+				action = strategy.Buy
+			} else if sell && !buy {
+				action = strategy.Sell
+			}
+		}
+
+		first = false
+		previousClosing = bar.close
+
+		return action
+	})
+
+	// Pivot points start only after the idle period.
+	actions = helper.Shift(actions, p.PivotPoint.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
+
+// Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
+func (p *PivotPointStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> openings    |
+	// snapshots[2] -> highs       |
+	// snapshots[3] -> lows        |
+	// snapshots[4] -> closings[0] -> pivots, r1s, r2s, r3s, s1s, s2s, s3s
+	//                 closings[1] -> closings
+	// snapshots[5] -> actions     -> annotations
+	//              -> outcomes
+	//
+	snapshots := helper.Duplicate(c, 6)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	openings := asset.SnapshotsAsOpenings(snapshots[1])
+	highs := asset.SnapshotsAsHighs(snapshots[2])
+	lows := asset.SnapshotsAsLows(snapshots[3])
+	closings := helper.Duplicate(asset.SnapshotsAsClosings(snapshots[4]), 2)
+
+	levels := []string{"P", "R1", "R2", "R3", "S1", "S2", "S3"}
+	pivots, r1s, r2s, r3s, s1s, s2s, s3s := p.PivotPoint.Compute(openings, highs, lows, closings[0])
+	values := []<-chan float64{pivots, r1s, r2s, r3s, s1s, s2s, s3s}
+
+	actions, outcomes := strategy.ComputeWithOutcome(p, snapshots[5])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(p.Name(), dates)
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings[1]))
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations))
+
+	for i, level := range levels {
+		report.AddColumn(helper.NewStepReportColumn(level, helper.Shift(values[i], p.PivotPoint.IdlePeriod(), 0)))
+	}
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 1)
+
+	return report
+}
@@ -23,19 +23,24 @@ import "github.com/miromax42/indicator/v2/strategy"
 // AllStrategies returns a slice containing references to all available trend strategies.
 func AllStrategies() []strategy.Strategy {
 	return []strategy.Strategy{
+		NewAdxStrategy(),
 		NewApoStrategy(),
 		NewAroonStrategy(),
 		NewBopStrategy(),
 		NewCciStrategy(),
+		NewCfoStrategy(),
 		NewDemaStrategy(),
 		NewGoldenCrossStrategy(),
 		NewKamaStrategy(),
 		NewKdjStrategy(),
 		NewMacdStrategy(),
+		NewParabolicSarStrategy(),
+		NewPivotPointStrategy(),
 		NewQstickStrategy(),
 		NewTrimaStrategy(),
 		NewTripleMovingAverageCrossoverStrategy(),
 		NewTsiStrategy(),
+		NewVortexStrategy(),
 		NewVwmaStrategy(),
 	}
 }
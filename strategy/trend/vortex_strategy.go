@@ -0,0 +1,120 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"fmt"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/strategy"
+	"github.com/miromax42/indicator/v2/trend"
+)
+
+// VortexStrategy represents the configuration parameters for calculating the Vortex strategy.
+// It recommends a Buy action when the positive vortex line (VI+) crosses above the negative
+// vortex line (VI-), and a Sell action when the negative vortex line crosses above the
+// positive vortex line.
+type VortexStrategy struct {
+	// Vortex represents the configuration parameters for calculating the Vortex Indicator.
+	Vortex *trend.Vortex[float64]
+}
+
+// NewVortexStrategy function initializes a new Vortex strategy instance with the default parameters.
+func NewVortexStrategy() *VortexStrategy {
+	return NewVortexStrategyWith(trend.DefaultVortexPeriod)
+}
+
+// NewVortexStrategyWith function initializes a new Vortex strategy instance with the given period.
+func NewVortexStrategyWith(period int) *VortexStrategy {
+	return &VortexStrategy{
+		Vortex: trend.NewVortexWithPeriod[float64](period),
+	}
+}
+
+// Name returns the name of the strategy.
+func (v *VortexStrategy) Name() string {
+	return fmt.Sprintf("Vortex Strategy (%d)", v.Vortex.Period)
+}
+
+// Compute processes the provided asset snapshots and generates a stream of actionable recommendations.
+func (v *VortexStrategy) Compute(c <-chan *asset.Snapshot) <-chan strategy.Action {
+	snapshots := helper.Duplicate(c, 3)
+
+	highs := asset.SnapshotsAsHighs(snapshots[0])
+	lows := asset.SnapshotsAsLows(snapshots[1])
+	closings := asset.SnapshotsAsClosings(snapshots[2])
+
+	plus, minus := v.Vortex.Compute(highs, lows, closings)
+
+	first := true
+	var previousPlus, previousMinus float64
+
+	actions := helper.Operate(plus, minus, func(plus, minus float64) strategy.Action {
+		action := strategy.Hold
+
+		if !first {
+			if previousPlus <= previousMinus && plus > minus {
+				action = strategy.Buy
+			} else if previousMinus <= previousPlus && minus > plus {
+				action = strategy.Sell
+			}
+		}
+
+		first = false
+		previousPlus = plus
+		previousMinus = minus
+
+		return action
+	})
+
+	// Vortex starts only after a full period.
+	actions = helper.Shift(actions, v.Vortex.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
+
+// Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
+func (v *VortexStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> highs       |
+	// snapshots[2] -> lows        | -> plus, minus
+	// snapshots[3] -> closings[0] |
+	//                 closings[1] -> closings
+	// snapshots[4] -> actions     -> annotations
+	//              -> outcomes
+	//
+	snapshots := helper.Duplicate(c, 5)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	highs := asset.SnapshotsAsHighs(snapshots[1])
+	lows := asset.SnapshotsAsLows(snapshots[2])
+	closingsSplice := helper.Duplicate(
+		asset.SnapshotsAsClosings(snapshots[3]),
+		2,
+	)
+
+	plus, minus := v.Vortex.Compute(highs, lows, closingsSplice[0])
+	plus = helper.Shift(plus, v.Vortex.IdlePeriod(), 0)
+	minus = helper.Shift(minus, v.Vortex.IdlePeriod(), 0)
+
+	actions, outcomes := strategy.ComputeWithOutcome(v, snapshots[4])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(v.Name(), dates)
+	report.AddChart()
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closingsSplice[1]))
+	report.AddColumn(helper.NewNumericReportColumn("VI+", plus), 1)
+	report.AddColumn(helper.NewNumericReportColumn("VI-", minus), 1)
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations), 0, 1)
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 2)
+
+	return report
+}
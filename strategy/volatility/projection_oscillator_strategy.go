@@ -0,0 +1,141 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility
+
+import (
+	"fmt"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/strategy"
+	"github.com/miromax42/indicator/v2/trend"
+	"github.com/miromax42/indicator/v2/volatility"
+)
+
+const (
+	// DefaultProjectionOscillatorStrategyTriggerPeriod is the default period of the EMA
+	// used as the trigger line.
+	DefaultProjectionOscillatorStrategyTriggerPeriod = 3
+)
+
+// ProjectionOscillatorStrategy represents the configuration parameters for calculating the Projection
+// Oscillator strategy. The trigger line is the EMA of the Projection Oscillator (PO). A PO crossing
+// above the trigger line suggests a Buy signal, while crossing below the trigger line suggests a
+// Sell signal.
+type ProjectionOscillatorStrategy struct {
+	// ProjectionOscillator represents the configuration parameters for calculating the Projection Oscillator.
+	ProjectionOscillator *volatility.Po[float64]
+
+	// Trigger is the EMA instance for the trigger line.
+	Trigger *trend.Ema[float64]
+}
+
+// NewProjectionOscillatorStrategy function initializes a new Projection Oscillator strategy instance with
+// the default parameters.
+func NewProjectionOscillatorStrategy() *ProjectionOscillatorStrategy {
+	return NewProjectionOscillatorStrategyWith(
+		volatility.DefaultPoPeriod,
+		DefaultProjectionOscillatorStrategyTriggerPeriod,
+	)
+}
+
+// NewProjectionOscillatorStrategyWith function initializes a new Projection Oscillator strategy instance
+// with the given period and trigger period.
+func NewProjectionOscillatorStrategyWith(period, triggerPeriod int) *ProjectionOscillatorStrategy {
+	return &ProjectionOscillatorStrategy{
+		ProjectionOscillator: volatility.NewPoWithPeriod[float64](period),
+		Trigger:              trend.NewEmaWithPeriod[float64](triggerPeriod),
+	}
+}
+
+// Name returns the name of the strategy.
+func (p *ProjectionOscillatorStrategy) Name() string {
+	return fmt.Sprintf("Projection Oscillator Strategy (%d, %d)", p.ProjectionOscillator.Period(), p.Trigger.Period)
+}
+
+// Compute processes the provided asset snapshots and generates a stream of actionable recommendations.
+func (p *ProjectionOscillatorStrategy) Compute(c <-chan *asset.Snapshot) <-chan strategy.Action {
+	snapshots := helper.Duplicate(c, 3)
+
+	highs := asset.SnapshotsAsHighs(snapshots[0])
+	lows := asset.SnapshotsAsLows(snapshots[1])
+	closings := asset.SnapshotsAsClosings(snapshots[2])
+
+	posSplice := helper.Duplicate(p.ProjectionOscillator.Compute(highs, lows, closings), 2)
+	posSplice[0] = helper.Skip(posSplice[0], p.Trigger.IdlePeriod())
+
+	triggers := p.Trigger.Compute(posSplice[1])
+
+	first := true
+	var previousPo, previousTrigger float64
+
+	actions := helper.Operate(posSplice[0], triggers, func(po, trigger float64) strategy.Action {
+		action := strategy.Hold
+
+		if !first {
+			if previousPo <= previousTrigger && po > trigger {
+				action = strategy.Buy
+			} else if previousPo >= previousTrigger && po < trigger {
+				action = strategy.Sell
+			}
+		}
+
+		first = false
+		previousPo = po
+		previousTrigger = trigger
+
+		return action
+	})
+
+	// Trigger starts only after the idle periods.
+	actions = helper.Shift(actions, p.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
+
+// Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
+func (p *ProjectionOscillatorStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> highs       |
+	// snapshots[2] -> lows        | -> pos[0] -> pos
+	// snapshots[3] -> closings[0] |    pos[1] -> triggers
+	//                 closings[1] -> closings
+	// snapshots[4] -> actions     -> annotations
+	//              -> outcomes
+	//
+	snapshots := helper.Duplicate(c, 5)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	highs := asset.SnapshotsAsHighs(snapshots[1])
+	lows := asset.SnapshotsAsLows(snapshots[2])
+	closingsSplice := helper.Duplicate(asset.SnapshotsAsClosings(snapshots[3]), 2)
+
+	posSplice := helper.Duplicate(p.ProjectionOscillator.Compute(highs, lows, closingsSplice[0]), 2)
+	pos := helper.Shift(posSplice[0], p.ProjectionOscillator.IdlePeriod(), 0)
+	triggers := helper.Shift(p.Trigger.Compute(posSplice[1]), p.IdlePeriod(), 0)
+
+	actions, outcomes := strategy.ComputeWithOutcome(p, snapshots[4])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(p.Name(), dates)
+	report.AddChart()
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closingsSplice[1]))
+	report.AddColumn(helper.NewNumericReportColumn("PO", pos), 1)
+	report.AddColumn(helper.NewNumericReportColumn("Trigger", triggers), 1)
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations), 0, 1)
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 2)
+
+	return report
+}
+
+// IdlePeriod is the initial period that Projection Oscillator strategy won't yield any results.
+func (p *ProjectionOscillatorStrategy) IdlePeriod() int {
+	return p.ProjectionOscillator.IdlePeriod() + p.Trigger.IdlePeriod()
+}
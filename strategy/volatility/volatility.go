@@ -28,6 +28,7 @@ import (
 func AllStrategies() []strategy.Strategy {
 	return []strategy.Strategy{
 		NewBollingerBandsStrategy(),
+		NewProjectionOscillatorStrategy(),
 		NewSuperTrendStrategy(),
 		NewSuperTrendStrategyWith(
 			volatility.NewSuperTrendWithMa[float64](
@@ -0,0 +1,111 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volume
+
+import (
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/strategy"
+	"github.com/miromax42/indicator/v2/volume"
+)
+
+// ChaikinMoneyFlowStrategy represents the configuration parameters for calculating the Chaikin Money
+// Flow strategy. A positive CMF value suggests buying pressure and recommends a Buy action, while a
+// negative CMF value suggests selling pressure and recommends a Sell action.
+type ChaikinMoneyFlowStrategy struct {
+	// ChaikinMoneyFlow is the Chaikin Money Flow indicator instance.
+	ChaikinMoneyFlow *volume.Cmf[float64]
+}
+
+// NewChaikinMoneyFlowStrategy function initializes a new Chaikin Money Flow strategy instance with the
+// default parameters.
+func NewChaikinMoneyFlowStrategy() *ChaikinMoneyFlowStrategy {
+	return NewChaikinMoneyFlowStrategyWith(volume.DefaultCmfPeriod)
+}
+
+// NewChaikinMoneyFlowStrategyWith function initializes a new Chaikin Money Flow strategy instance with the
+// given period.
+func NewChaikinMoneyFlowStrategyWith(period int) *ChaikinMoneyFlowStrategy {
+	return &ChaikinMoneyFlowStrategy{
+		ChaikinMoneyFlow: volume.NewCmfWithPeriod[float64](period),
+	}
+}
+
+// Name returns the name of the strategy.
+func (*ChaikinMoneyFlowStrategy) Name() string {
+	return "Chaikin Money Flow Strategy"
+}
+
+// Compute processes the provided asset snapshots and generates a stream of actionable recommendations.
+func (c *ChaikinMoneyFlowStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	snapshotsSplice := helper.Duplicate(snapshots, 4)
+
+	highs := asset.SnapshotsAsHighs(snapshotsSplice[0])
+	lows := asset.SnapshotsAsLows(snapshotsSplice[1])
+	closings := asset.SnapshotsAsClosings(snapshotsSplice[2])
+	volumes := asset.SnapshotsAsVolumes(snapshotsSplice[3])
+
+	cmfs := c.ChaikinMoneyFlow.Compute(highs, lows, closings, volumes)
+
+	actions := helper.Map(cmfs, func(cmf float64) strategy.Action {
+		if cmf > 0 {
+			return strategy.Buy
+		}
+
+		if cmf < 0 {
+			return strategy.Sell
+		}
+
+		return strategy.Hold
+	})
+
+	// CMF starts only after the idle period.
+	actions = helper.Shift(actions, c.ChaikinMoneyFlow.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
+
+// Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
+func (c *ChaikinMoneyFlowStrategy) Report(snapshots <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> highs       |
+	// snapshots[2] -> lows        |
+	// snapshots[3] -> closings[0] -> cmfs
+	//                 closings[1] -> closings
+	// snapshots[4] -> volumes     |
+	// snapshots[5] -> actions     -> annotations
+	//              -> outcomes
+	//
+	snapshotsSplice := helper.Duplicate(snapshots, 6)
+
+	dates := asset.SnapshotsAsDates(snapshotsSplice[0])
+	highs := asset.SnapshotsAsHighs(snapshotsSplice[1])
+	lows := asset.SnapshotsAsLows(snapshotsSplice[2])
+	closingsSplice := helper.Duplicate(asset.SnapshotsAsClosings(snapshotsSplice[3]), 2)
+	volumes := asset.SnapshotsAsVolumes(snapshotsSplice[4])
+
+	cmfs := helper.Shift(
+		c.ChaikinMoneyFlow.Compute(highs, lows, closingsSplice[0], volumes),
+		c.ChaikinMoneyFlow.IdlePeriod(),
+		0,
+	)
+
+	actions, outcomes := strategy.ComputeWithOutcome(c, snapshotsSplice[5])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(c.Name(), dates)
+	report.AddChart()
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closingsSplice[1]))
+	report.AddColumn(helper.NewNumericReportColumn("CMF", cmfs), 1)
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations), 0, 1)
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 2)
+
+	return report
+}
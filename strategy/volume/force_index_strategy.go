@@ -0,0 +1,103 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volume
+
+import (
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/strategy"
+	"github.com/miromax42/indicator/v2/volume"
+)
+
+// ForceIndexStrategy represents the configuration parameters for calculating the Force Index strategy.
+// A positive FI value suggests that the bulls are in control and recommends a Buy action, while a
+// negative FI value suggests that the bears are in control and recommends a Sell action.
+type ForceIndexStrategy struct {
+	// ForceIndex is the Force Index indicator instance.
+	ForceIndex *volume.Fi[float64]
+}
+
+// NewForceIndexStrategy function initializes a new Force Index strategy instance with the default parameters.
+func NewForceIndexStrategy() *ForceIndexStrategy {
+	return NewForceIndexStrategyWith(volume.DefaultFiPeriod)
+}
+
+// NewForceIndexStrategyWith function initializes a new Force Index strategy instance with the given period.
+func NewForceIndexStrategyWith(period int) *ForceIndexStrategy {
+	return &ForceIndexStrategy{
+		ForceIndex: volume.NewFiWithPeriod[float64](period),
+	}
+}
+
+// Name returns the name of the strategy.
+func (*ForceIndexStrategy) Name() string {
+	return "Force Index Strategy"
+}
+
+// Compute processes the provided asset snapshots and generates a stream of actionable recommendations.
+func (f *ForceIndexStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	snapshotsSplice := helper.Duplicate(snapshots, 2)
+
+	closings := asset.SnapshotsAsClosings(snapshotsSplice[0])
+	volumes := asset.SnapshotsAsVolumes(snapshotsSplice[1])
+
+	fis := f.ForceIndex.Compute(closings, volumes)
+
+	actions := helper.Map(fis, func(fi float64) strategy.Action {
+		if fi > 0 {
+			return strategy.Buy
+		}
+
+		if fi < 0 {
+			return strategy.Sell
+		}
+
+		return strategy.Hold
+	})
+
+	// FI starts only after the idle period.
+	actions = helper.Shift(actions, f.ForceIndex.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
+
+// Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
+func (f *ForceIndexStrategy) Report(snapshots <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> closings[0] -> fis
+	//                 closings[1] -> closings
+	// snapshots[2] -> volumes     |
+	// snapshots[3] -> actions     -> annotations
+	//              -> outcomes
+	//
+	snapshotsSplice := helper.Duplicate(snapshots, 4)
+
+	dates := asset.SnapshotsAsDates(snapshotsSplice[0])
+	closingsSplice := helper.Duplicate(asset.SnapshotsAsClosings(snapshotsSplice[1]), 2)
+	volumes := asset.SnapshotsAsVolumes(snapshotsSplice[2])
+
+	fis := helper.Shift(
+		f.ForceIndex.Compute(closingsSplice[0], volumes),
+		f.ForceIndex.IdlePeriod(),
+		0,
+	)
+
+	actions, outcomes := strategy.ComputeWithOutcome(f, snapshotsSplice[3])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(f.Name(), dates)
+	report.AddChart()
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closingsSplice[1]))
+	report.AddColumn(helper.NewNumericReportColumn("Force Index", fis), 1)
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations), 0, 1)
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 2)
+
+	return report
+}
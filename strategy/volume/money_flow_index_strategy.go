@@ -0,0 +1,133 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volume
+
+import (
+	"fmt"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/strategy"
+	"github.com/miromax42/indicator/v2/volume"
+)
+
+const (
+	// DefaultMoneyFlowIndexStrategyBuyAt defines the default MFI level at which a Buy action is generated.
+	DefaultMoneyFlowIndexStrategyBuyAt = 20
+
+	// DefaultMoneyFlowIndexStrategySellAt defines the default MFI level at which a Sell action is generated.
+	DefaultMoneyFlowIndexStrategySellAt = 80
+)
+
+// MoneyFlowIndexStrategy represents the configuration parameters for calculating the Money Flow Index
+// strategy. An MFI value at or below the buy level suggests an oversold state and recommends a Buy
+// action, while an MFI value at or above the sell level suggests an overbought state and recommends
+// a Sell action.
+type MoneyFlowIndexStrategy struct {
+	// MoneyFlowIndex is the Money Flow Index indicator instance.
+	MoneyFlowIndex *volume.Mfi[float64]
+
+	// BuyAt defines the MFI level at which a Buy action is generated.
+	BuyAt float64
+
+	// SellAt defines the MFI level at which a Sell action is generated.
+	SellAt float64
+}
+
+// NewMoneyFlowIndexStrategy function initializes a new Money Flow Index strategy instance with the
+// default parameters.
+func NewMoneyFlowIndexStrategy() *MoneyFlowIndexStrategy {
+	return NewMoneyFlowIndexStrategyWith(
+		DefaultMoneyFlowIndexStrategyBuyAt,
+		DefaultMoneyFlowIndexStrategySellAt,
+	)
+}
+
+// NewMoneyFlowIndexStrategyWith function initializes a new Money Flow Index strategy instance with the
+// given parameters.
+func NewMoneyFlowIndexStrategyWith(buyAt, sellAt float64) *MoneyFlowIndexStrategy {
+	return &MoneyFlowIndexStrategy{
+		MoneyFlowIndex: volume.NewMfi[float64](),
+		BuyAt:          buyAt,
+		SellAt:         sellAt,
+	}
+}
+
+// Name returns the name of the strategy.
+func (m *MoneyFlowIndexStrategy) Name() string {
+	return fmt.Sprintf("Money Flow Index Strategy %.0f-%.0f", m.BuyAt, m.SellAt)
+}
+
+// Compute processes the provided asset snapshots and generates a stream of actionable recommendations.
+func (m *MoneyFlowIndexStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	snapshotsSplice := helper.Duplicate(snapshots, 4)
+
+	highs := asset.SnapshotsAsHighs(snapshotsSplice[0])
+	lows := asset.SnapshotsAsLows(snapshotsSplice[1])
+	closings := asset.SnapshotsAsClosings(snapshotsSplice[2])
+	volumes := asset.SnapshotsAsVolumes(snapshotsSplice[3])
+
+	mfis := m.MoneyFlowIndex.Compute(highs, lows, closings, volumes)
+
+	actions := helper.Map(mfis, func(mfi float64) strategy.Action {
+		if mfi <= m.BuyAt {
+			return strategy.Buy
+		}
+
+		if mfi >= m.SellAt {
+			return strategy.Sell
+		}
+
+		return strategy.Hold
+	})
+
+	// MFI starts only after the idle period.
+	actions = helper.Shift(actions, m.MoneyFlowIndex.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
+
+// Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
+func (m *MoneyFlowIndexStrategy) Report(snapshots <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> highs       |
+	// snapshots[2] -> lows        |
+	// snapshots[3] -> closings[0] -> mfis
+	//                 closings[1] -> closings
+	// snapshots[4] -> volumes     |
+	// snapshots[5] -> actions     -> annotations
+	//              -> outcomes
+	//
+	snapshotsSplice := helper.Duplicate(snapshots, 6)
+
+	dates := asset.SnapshotsAsDates(snapshotsSplice[0])
+	highs := asset.SnapshotsAsHighs(snapshotsSplice[1])
+	lows := asset.SnapshotsAsLows(snapshotsSplice[2])
+	closingsSplice := helper.Duplicate(asset.SnapshotsAsClosings(snapshotsSplice[3]), 2)
+	volumes := asset.SnapshotsAsVolumes(snapshotsSplice[4])
+
+	mfis := helper.Shift(
+		m.MoneyFlowIndex.Compute(highs, lows, closingsSplice[0], volumes),
+		m.MoneyFlowIndex.IdlePeriod(),
+		0,
+	)
+
+	actions, outcomes := strategy.ComputeWithOutcome(m, snapshotsSplice[5])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(m.Name(), dates)
+	report.AddChart()
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closingsSplice[1]))
+	report.AddColumn(helper.NewNumericReportColumn("MFI", mfis), 1)
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations), 0, 1)
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 2)
+
+	return report
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volume
+
+import (
+	"fmt"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/strategy"
+	"github.com/miromax42/indicator/v2/trend"
+	"github.com/miromax42/indicator/v2/volume"
+)
+
+const (
+	// DefaultNegativeVolumeIndexStrategyEmaPeriod is the default EMA period of 255 days
+	// that the NVI is compared with.
+	DefaultNegativeVolumeIndexStrategyEmaPeriod = 255
+)
+
+// NegativeVolumeIndexStrategy represents the configuration parameters for calculating the Negative
+// Volume Index strategy. An NVI value above its EMA suggests that the smart money is buying and
+// recommends a Buy action, while an NVI value below its EMA suggests that the smart money is
+// selling and recommends a Sell action.
+type NegativeVolumeIndexStrategy struct {
+	// NegativeVolumeIndex is the Negative Volume Index indicator instance.
+	NegativeVolumeIndex *volume.Nvi[float64]
+
+	// NegativeVolumeIndexEma is the EMA instance for the NVI.
+	NegativeVolumeIndexEma *trend.Ema[float64]
+}
+
+// NewNegativeVolumeIndexStrategy function initializes a new Negative Volume Index strategy instance
+// with the default parameters.
+func NewNegativeVolumeIndexStrategy() *NegativeVolumeIndexStrategy {
+	return NewNegativeVolumeIndexStrategyWith(DefaultNegativeVolumeIndexStrategyEmaPeriod)
+}
+
+// NewNegativeVolumeIndexStrategyWith function initializes a new Negative Volume Index strategy instance
+// with the given EMA period.
+func NewNegativeVolumeIndexStrategyWith(emaPeriod int) *NegativeVolumeIndexStrategy {
+	return &NegativeVolumeIndexStrategy{
+		NegativeVolumeIndex:    volume.NewNvi[float64](),
+		NegativeVolumeIndexEma: trend.NewEmaWithPeriod[float64](emaPeriod),
+	}
+}
+
+// Name returns the name of the strategy.
+func (n *NegativeVolumeIndexStrategy) Name() string {
+	return fmt.Sprintf("Negative Volume Index Strategy (%d)", n.NegativeVolumeIndexEma.Period)
+}
+
+// Compute processes the provided asset snapshots and generates a stream of actionable recommendations.
+func (n *NegativeVolumeIndexStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	snapshotsSplice := helper.Duplicate(snapshots, 2)
+
+	closings := asset.SnapshotsAsClosings(snapshotsSplice[0])
+	volumes := asset.SnapshotsAsVolumes(snapshotsSplice[1])
+
+	nvisSplice := helper.Duplicate(n.NegativeVolumeIndex.Compute(closings, volumes), 2)
+	nvisSplice[0] = helper.Skip(nvisSplice[0], n.NegativeVolumeIndexEma.IdlePeriod())
+
+	emas := n.NegativeVolumeIndexEma.Compute(nvisSplice[1])
+
+	actions := helper.Operate(nvisSplice[0], emas, func(nvi, ema float64) strategy.Action {
+		if nvi > ema {
+			return strategy.Buy
+		}
+
+		if nvi < ema {
+			return strategy.Sell
+		}
+
+		return strategy.Hold
+	})
+
+	// NVI EMA starts only after the idle periods.
+	actions = helper.Shift(actions, n.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
+
+// Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
+func (n *NegativeVolumeIndexStrategy) Report(snapshots <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> closings[0] -> nvis[0] -> nvis
+	//                                nvis[1] -> emas
+	//                 closings[1] -> closings
+	// snapshots[2] -> volumes     |
+	// snapshots[3] -> actions     -> annotations
+	//              -> outcomes
+	//
+	snapshotsSplice := helper.Duplicate(snapshots, 4)
+
+	dates := asset.SnapshotsAsDates(snapshotsSplice[0])
+	closingsSplice := helper.Duplicate(asset.SnapshotsAsClosings(snapshotsSplice[1]), 2)
+	volumes := asset.SnapshotsAsVolumes(snapshotsSplice[2])
+
+	nvisSplice := helper.Duplicate(n.NegativeVolumeIndex.Compute(closingsSplice[0], volumes), 2)
+	nvis := helper.Shift(nvisSplice[0], n.NegativeVolumeIndex.IdlePeriod(), 0)
+	emas := helper.Shift(n.NegativeVolumeIndexEma.Compute(nvisSplice[1]), n.IdlePeriod(), 0)
+
+	actions, outcomes := strategy.ComputeWithOutcome(n, snapshotsSplice[3])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(n.Name(), dates)
+	report.AddChart()
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closingsSplice[1]))
+	report.AddColumn(helper.NewNumericReportColumn("NVI", nvis), 1)
+	report.AddColumn(helper.NewNumericReportColumn("NVI EMA", emas), 1)
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations), 0, 1)
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 2)
+
+	return report
+}
+
+// IdlePeriod is the initial period that Negative Volume Index strategy won't yield any results.
+func (n *NegativeVolumeIndexStrategy) IdlePeriod() int {
+	return n.NegativeVolumeIndex.IdlePeriod() + n.NegativeVolumeIndexEma.IdlePeriod()
+}
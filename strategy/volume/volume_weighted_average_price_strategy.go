@@ -0,0 +1,107 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volume
+
+import (
+	"fmt"
+
+	"github.com/miromax42/indicator/v2/asset"
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/strategy"
+	"github.com/miromax42/indicator/v2/volume"
+)
+
+// VolumeWeightedAveragePriceStrategy represents the configuration parameters for calculating the Volume
+// Weighted Average Price strategy. A closing price above the VWAP suggests a bullish trend and recommends
+// a Buy action, while a closing price below the VWAP suggests a bearish trend and recommends a Sell action.
+type VolumeWeightedAveragePriceStrategy struct {
+	// VolumeWeightedAveragePrice is the Volume Weighted Average Price indicator instance.
+	VolumeWeightedAveragePrice *volume.Vwap[float64]
+}
+
+// NewVolumeWeightedAveragePriceStrategy function initializes a new Volume Weighted Average Price strategy
+// instance with the default parameters.
+func NewVolumeWeightedAveragePriceStrategy() *VolumeWeightedAveragePriceStrategy {
+	return NewVolumeWeightedAveragePriceStrategyWith(volume.DefaultVwapPeriod)
+}
+
+// NewVolumeWeightedAveragePriceStrategyWith function initializes a new Volume Weighted Average Price
+// strategy instance with the given period.
+func NewVolumeWeightedAveragePriceStrategyWith(period int) *VolumeWeightedAveragePriceStrategy {
+	return &VolumeWeightedAveragePriceStrategy{
+		VolumeWeightedAveragePrice: volume.NewVwapWithPeriod[float64](period),
+	}
+}
+
+// Name returns the name of the strategy.
+func (v *VolumeWeightedAveragePriceStrategy) Name() string {
+	return fmt.Sprintf("Volume Weighted Average Price Strategy (%d)", v.VolumeWeightedAveragePrice.Sum.Period)
+}
+
+// Compute processes the provided asset snapshots and generates a stream of actionable recommendations.
+func (v *VolumeWeightedAveragePriceStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	snapshotsSplice := helper.Duplicate(snapshots, 2)
+
+	closingsSplice := helper.Duplicate(asset.SnapshotsAsClosings(snapshotsSplice[0]), 2)
+	volumes := asset.SnapshotsAsVolumes(snapshotsSplice[1])
+
+	vwaps := v.VolumeWeightedAveragePrice.Compute(closingsSplice[0], volumes)
+	closingsSplice[1] = helper.Skip(closingsSplice[1], v.VolumeWeightedAveragePrice.IdlePeriod())
+
+	actions := helper.Operate(closingsSplice[1], vwaps, func(closing, vwap float64) strategy.Action {
+		if closing > vwap {
+			return strategy.Buy
+		}
+
+		if closing < vwap {
+			return strategy.Sell
+		}
+
+		return strategy.Hold
+	})
+
+	// VWAP starts only after the idle period.
+	actions = helper.Shift(actions, v.VolumeWeightedAveragePrice.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
+
+// Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
+func (v *VolumeWeightedAveragePriceStrategy) Report(snapshots <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> closings[0] -> vwaps
+	//                 closings[1] -> closings
+	// snapshots[2] -> volumes     |
+	// snapshots[3] -> actions     -> annotations
+	//              -> outcomes
+	//
+	snapshotsSplice := helper.Duplicate(snapshots, 4)
+
+	dates := asset.SnapshotsAsDates(snapshotsSplice[0])
+	closingsSplice := helper.Duplicate(asset.SnapshotsAsClosings(snapshotsSplice[1]), 2)
+	volumes := asset.SnapshotsAsVolumes(snapshotsSplice[2])
+
+	vwaps := helper.Shift(
+		v.VolumeWeightedAveragePrice.Compute(closingsSplice[0], volumes),
+		v.VolumeWeightedAveragePrice.IdlePeriod(),
+		0,
+	)
+
+	actions, outcomes := strategy.ComputeWithOutcome(v, snapshotsSplice[3])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(v.Name(), dates)
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closingsSplice[1]))
+	report.AddColumn(helper.NewNumericReportColumn("VWAP", vwaps))
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations))
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 1)
+
+	return report
+}
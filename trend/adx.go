@@ -0,0 +1,76 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"math"
+
+	"github.com/miromax42/indicator/v2/helper"
+)
+
+const (
+	// DefaultAdxPeriod is the default period for the Average Directional Index.
+	DefaultAdxPeriod = 14
+)
+
+// Adx represents the configuration parameters for calculating the Average Directional Index (ADX). It
+// measures the strength of a trend, regardless of its direction, based on the Directional Movement
+// Index (DMI). A value above 25 typically suggests a strong trend, while a value below 20 suggests
+// a weak or an absent trend.
+//
+//	DX = 100 * Abs(+DI - -DI) / (+DI + -DI)
+//	ADX = RMA(DX)
+//
+// Example:
+//
+//	adx := trend.NewAdx[float64]()
+//	result := adx.Compute(highs, lows, closings)
+type Adx[T helper.Number] struct {
+	// Dmi is the Directional Movement Index instance.
+	Dmi *Dmi[T]
+
+	// Rma is the RMA instance for smoothing the DX.
+	Rma *Rma[T]
+}
+
+// NewAdx function initializes a new ADX instance with the default parameters.
+func NewAdx[T helper.Number]() *Adx[T] {
+	return NewAdxWithPeriod[T](DefaultAdxPeriod)
+}
+
+// NewAdxWithPeriod function initializes a new ADX instance with the given period.
+func NewAdxWithPeriod[T helper.Number](period int) *Adx[T] {
+	return &Adx[T]{
+		Dmi: NewDmiWithPeriod[T](period),
+		Rma: NewRmaWithPeriod[T](period),
+	}
+}
+
+// Compute function takes channels of highs, lows, and closings, and computes the ADX.
+func (a *Adx[T]) Compute(highs, lows, closings <-chan T) <-chan T {
+	plusDis, minusDis := a.Dmi.Compute(highs, lows, closings)
+	return a.ComputeWithDi(plusDis, minusDis)
+}
+
+// ComputeWithDi function takes channels of the positive and negative directional
+// indicators, as computed by the DMI, and computes the ADX.
+func (a *Adx[T]) ComputeWithDi(plusDis, minusDis <-chan T) <-chan T {
+	// DX = 100 * Abs(+DI - -DI) / (+DI + -DI)
+	dxs := helper.Operate(plusDis, minusDis, func(plusDi, minusDi T) T {
+		if plusDi+minusDi == 0 {
+			return 0
+		}
+
+		return T(100 * math.Abs(float64(plusDi-minusDi)) / float64(plusDi+minusDi))
+	})
+
+	// ADX = RMA(DX)
+	return a.Rma.Compute(dxs)
+}
+
+// IdlePeriod is the initial period that ADX won't yield any results.
+func (a *Adx[T]) IdlePeriod() int {
+	return a.Dmi.IdlePeriod() + a.Rma.IdlePeriod()
+}
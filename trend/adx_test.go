@@ -0,0 +1,44 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend_test
+
+import (
+	"testing"
+
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/trend"
+)
+
+func TestAdx(t *testing.T) {
+	type AdxData struct {
+		High  float64
+		Low   float64
+		Close float64
+		Adx   float64
+	}
+
+	input, err := helper.ReadFromCsvFile[AdxData]("testdata/adx.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	adx := trend.NewAdx[float64]()
+
+	inputs := helper.Duplicate(input, 4)
+	highs := helper.Map(inputs[0], func(d *AdxData) float64 { return d.High })
+	lows := helper.Map(inputs[1], func(d *AdxData) float64 { return d.Low })
+	closings := helper.Map(inputs[2], func(d *AdxData) float64 { return d.Close })
+	expected := helper.Map(inputs[3], func(d *AdxData) float64 { return d.Adx })
+
+	expected = helper.Skip(expected, adx.IdlePeriod())
+
+	actual := adx.Compute(highs, lows, closings)
+	actual = helper.RoundDigits(actual, 2)
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import "github.com/miromax42/indicator/v2/helper"
+
+const (
+	// DefaultCfoPeriod is the default period for the Chande Forecast Oscillator.
+	DefaultCfoPeriod = 14
+)
+
+// Cfo represents the configuration parameters for calculating the Chande Forecast
+// Oscillator (CFO). It measures the percentage difference between the closing price
+// and the value forecasted by the Moving Linear Regression over the period. A positive
+// value suggests that the price is above its forecast, and a negative value suggests
+// that the price is below its forecast.
+//
+//	CFO = ((Close - MLR(Close)) / Close) * 100
+//
+// Example:
+//
+//	cfo := trend.NewCfo[float64]()
+//	result := cfo.Compute(closings)
+type Cfo[T helper.Number] struct {
+	// Mlr is the Moving Linear Regression instance.
+	Mlr *Mlr[T]
+}
+
+// NewCfo function initializes a new CFO instance with the default parameters.
+func NewCfo[T helper.Number]() *Cfo[T] {
+	return NewCfoWithPeriod[T](DefaultCfoPeriod)
+}
+
+// NewCfoWithPeriod function initializes a new CFO instance with the given period.
+func NewCfoWithPeriod[T helper.Number](period int) *Cfo[T] {
+	return &Cfo[T]{
+		Mlr: NewMlrWithPeriod[T](period),
+	}
+}
+
+// Compute function takes a channel of closings and computes the CFO over the specified period.
+func (c *Cfo[T]) Compute(closings <-chan T) <-chan T {
+	closingsSplice := helper.Duplicate(closings, 3)
+
+	// The closings are regressed on their positions.
+	x := helper.Count(T(0), closingsSplice[0])
+	forecasts := c.Mlr.Compute(x, closingsSplice[1])
+
+	closingsSplice[2] = helper.Skip(closingsSplice[2], c.Mlr.IdlePeriod())
+
+	// CFO = ((Close - MLR(Close)) / Close) * 100
+	cfo := helper.Operate(closingsSplice[2], forecasts, func(closing, forecast T) T {
+		return (closing - forecast) / closing * 100
+	})
+
+	return cfo
+}
+
+// IdlePeriod is the initial period that CFO won't yield any results.
+func (c *Cfo[T]) IdlePeriod() int {
+	return c.Mlr.IdlePeriod()
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"math"
+
+	"github.com/miromax42/indicator/v2/helper"
+)
+
+const (
+	// DefaultDmiPeriod is the default period for the Directional Movement Index.
+	DefaultDmiPeriod = 14
+)
+
+// Dmi represents the configuration parameters for calculating the Directional Movement Index (DMI). It
+// consists of the Positive Directional Indicator (+DI) and the Negative Directional Indicator (-DI),
+// measuring the upward and downward price movements relative to the true range. The movements and the
+// true range are smoothed using the RMA, and the smoothed true range is the Average True Range (ATR)
+// as defined by Wilder.
+//
+//	Up Move = High - Previous High
+//	Down Move = Previous Low - Low
+//	+DM = If (Up Move > Down Move) And (Up Move > 0) Then Up Move Else 0
+//	-DM = If (Down Move > Up Move) And (Down Move > 0) Then Down Move Else 0
+//	TR = Max((High - Low), (High - Previous Closing), (Previous Closing - Low))
+//	+DI = 100 * RMA(+DM) / RMA(TR)
+//	-DI = 100 * RMA(-DM) / RMA(TR)
+//
+// Example:
+//
+//	dmi := trend.NewDmi[float64]()
+//	plusDi, minusDi := dmi.Compute(highs, lows, closings)
+type Dmi[T helper.Number] struct {
+	// Period is the period to use.
+	Period int
+}
+
+// dmiMovement is the directional movements and the true range at a single bar.
+type dmiMovement[T helper.Number] struct {
+	plus  T
+	minus T
+	tr    T
+}
+
+// NewDmi function initializes a new DMI instance with the default parameters.
+func NewDmi[T helper.Number]() *Dmi[T] {
+	return NewDmiWithPeriod[T](DefaultDmiPeriod)
+}
+
+// NewDmiWithPeriod function initializes a new DMI instance with the given period.
+func NewDmiWithPeriod[T helper.Number](period int) *Dmi[T] {
+	return &Dmi[T]{
+		Period: period,
+	}
+}
+
+// Compute function takes channels of highs, lows, and closings, and computes the
+// positive and negative directional indicators over the specified period.
+func (d *Dmi[T]) Compute(highs, lows, closings <-chan T) (<-chan T, <-chan T) {
+	first := true
+	var previousHigh, previousLow, previousClosing T
+
+	movements := helper.Operate3(highs, lows, closings, func(high, low, closing T) *dmiMovement[T] {
+		upMove := high - previousHigh
+		downMove := previousLow - low
+
+		movement := &dmiMovement[T]{
+			tr: T(math.Max(float64(high-low), math.Max(float64(high-previousClosing), float64(previousClosing-low)))),
+		}
+
+		if upMove > downMove && upMove > 0 {
+			movement.plus = upMove
+		}
+
+		if downMove > upMove && downMove > 0 {
+			movement.minus = downMove
+		}
+
+		if first {
+			first = false
+			movement = nil
+		}
+
+		previousHigh = high
+		previousLow = low
+		previousClosing = closing
+
+		return movement
+	})
+
+	// Use the previous values by skipping the first movement.
+	movementsSplice := helper.Duplicate(helper.Skip(movements, 1), 3)
+
+	plusDms := NewRmaWithPeriod[T](d.Period).Compute(
+		helper.Map(movementsSplice[0], func(m *dmiMovement[T]) T { return m.plus }),
+	)
+
+	minusDms := NewRmaWithPeriod[T](d.Period).Compute(
+		helper.Map(movementsSplice[1], func(m *dmiMovement[T]) T { return m.minus }),
+	)
+
+	atrsSplice := helper.Duplicate(
+		NewRmaWithPeriod[T](d.Period).Compute(
+			helper.Map(movementsSplice[2], func(m *dmiMovement[T]) T { return m.tr }),
+		),
+		2,
+	)
+
+	// +DI = 100 * RMA(+DM) / RMA(TR)
+	plusDis := helper.MultiplyBy(helper.Divide(plusDms, atrsSplice[0]), 100)
+
+	// -DI = 100 * RMA(-DM) / RMA(TR)
+	minusDis := helper.MultiplyBy(helper.Divide(minusDms, atrsSplice[1]), 100)
+
+	return plusDis, minusDis
+}
+
+// IdlePeriod is the initial period that DMI won't yield any results.
+func (d *Dmi[T]) IdlePeriod() int {
+	// RMA idle period and for using the previous values.
+	return d.Period
+}
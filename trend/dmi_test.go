@@ -0,0 +1,48 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend_test
+
+import (
+	"testing"
+
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/trend"
+)
+
+func TestDmi(t *testing.T) {
+	type DmiData struct {
+		High    float64
+		Low     float64
+		Close   float64
+		PlusDi  float64
+		MinusDi float64
+	}
+
+	input, err := helper.ReadFromCsvFile[DmiData]("testdata/dmi.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dmi := trend.NewDmi[float64]()
+
+	inputs := helper.Duplicate(input, 5)
+	highs := helper.Map(inputs[0], func(d *DmiData) float64 { return d.High })
+	lows := helper.Map(inputs[1], func(d *DmiData) float64 { return d.Low })
+	closings := helper.Map(inputs[2], func(d *DmiData) float64 { return d.Close })
+	expectedPlusDi := helper.Map(inputs[3], func(d *DmiData) float64 { return d.PlusDi })
+	expectedMinusDi := helper.Map(inputs[4], func(d *DmiData) float64 { return d.MinusDi })
+
+	expectedPlusDi = helper.Skip(expectedPlusDi, dmi.IdlePeriod())
+	expectedMinusDi = helper.Skip(expectedMinusDi, dmi.IdlePeriod())
+
+	actualPlusDi, actualMinusDi := dmi.Compute(highs, lows, closings)
+	actualPlusDi = helper.RoundDigits(actualPlusDi, 2)
+	actualMinusDi = helper.RoundDigits(actualMinusDi, 2)
+
+	err = helper.CheckEquals(actualPlusDi, expectedPlusDi, actualMinusDi, expectedMinusDi)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
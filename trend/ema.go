@@ -32,6 +32,15 @@ type Ema[T helper.Number] struct {
 
 	// Smoothing constant.
 	Smoothing T
+
+	// sma is the SMA for the initial value of the incremental updates.
+	sma *Sma[T]
+
+	// before is the last EMA value of the incremental updates.
+	before T
+
+	// ready indicates whether the incremental updates passed the idle period.
+	ready bool
 }
 
 // NewEma function initializes a new EMA instance with the default parameters.
@@ -75,6 +84,32 @@ func (e *Ema[T]) Compute(c <-chan T) <-chan T {
 	return result
 }
 
+// Update takes the next value and computes the EMA incrementally. It returns
+// false until the EMA yields its first result after the idle period.
+func (e *Ema[T]) Update(n T) (T, bool) {
+	if e.ready {
+		multiplier := e.Smoothing / T(e.Period+1)
+		e.before = (n-e.before)*multiplier + e.before
+
+		return e.before, true
+	}
+
+	// Initial EMA value is the SMA.
+	if e.sma == nil {
+		e.sma = NewSmaWithPeriod[T](e.Period)
+	}
+
+	before, ok := e.sma.Update(n)
+	if !ok {
+		return 0, false
+	}
+
+	e.before = before
+	e.ready = true
+
+	return e.before, true
+}
+
 // IdlePeriod is the initial period that EMA yield any results.
 func (e *Ema[T]) IdlePeriod() int {
 	return e.Period - 1
@@ -47,3 +47,37 @@ func TestEmaString(t *testing.T) {
 		t.Fatalf("actual %v expected %v", actual, expected)
 	}
 }
+
+func TestEmaUpdate(t *testing.T) {
+	type Data struct {
+		Close float64
+	}
+
+	rows, err := helper.ReadFromCsvFile[Data]("testdata/wma.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := helper.ChanToSlice(helper.Map(rows, func(d *Data) float64 { return d.Close }))
+
+	ema := trend.NewEmaWithPeriod[float64](10)
+	expected := ema.Compute(helper.SliceToChan(input))
+
+	var actual []float64
+
+	for i, n := range input {
+		value, ok := ema.Update(n)
+		if ok != (i >= ema.IdlePeriod()) {
+			t.Fatalf("index %d actual %v expected %v", i, ok, !ok)
+		}
+
+		if ok {
+			actual = append(actual, value)
+		}
+	}
+
+	err = helper.CheckEquals(helper.SliceToChan(actual), expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -19,3 +19,13 @@ type Ma[T helper.Number] interface {
 	// String is the string representation of the MA instance.
 	String() string
 }
+
+// IncrementalMa represents the interface for the Moving Average (MA) indicators
+// that can also be computed incrementally, one value at a time.
+type IncrementalMa[T helper.Number] interface {
+	Ma[T]
+
+	// Update takes the next value and returns the MA along with whether the MA
+	// has passed its idle period and the returned value is valid.
+	Update(T) (T, bool)
+}
@@ -67,6 +67,27 @@ func (m *Macd[T]) Compute(c <-chan T) (<-chan T, <-chan T) {
 	return macds[0], signal
 }
 
+// Update takes the next value and computes the MACD and the signal line
+// incrementally. It returns false until the MACD yields its first result
+// after the idle period.
+func (m *Macd[T]) Update(n T) (T, T, bool) {
+	ema1, _ := m.Ema1.Update(n)
+
+	ema2, ok := m.Ema2.Update(n)
+	if !ok {
+		return 0, 0, false
+	}
+
+	macd := ema1 - ema2
+
+	signal, ok := m.Ema3.Update(macd)
+	if !ok {
+		return 0, 0, false
+	}
+
+	return macd, signal, true
+}
+
 // IdlePeriod is the initial period that MACD won't yield any results.
 func (m *Macd[T]) IdlePeriod() int {
 	return m.Ema2.Period + m.Ema3.Period - 2
@@ -5,6 +5,8 @@
 package trend_test
 
 import (
+	"context"
+	"runtime"
 	"testing"
 
 	"github.com/miromax42/indicator/v2/helper"
@@ -47,3 +49,73 @@ func TestMacd(t *testing.T) {
 		}
 	}
 }
+
+func TestMacdWithContext(t *testing.T) {
+	type Data struct {
+		Close float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/macd.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	closings := helper.ChanToSlice(helper.Map(input, func(d *Data) float64 { return d.Close }))
+
+	goroutines := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	macd := trend.NewMacd[float64]()
+	macds, signals := macd.Compute(helper.WithContext(ctx, helper.SliceToChanWithContext(ctx, closings)))
+
+	macds = helper.OutputWithContext(ctx, macds)
+	signals = helper.OutputWithContext(ctx, signals)
+
+	<-macds
+	<-signals
+	cancel()
+
+	err = helper.CheckNoGoroutineLeak(goroutines)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMacdUpdate(t *testing.T) {
+	type Data struct {
+		Close float64
+	}
+
+	rows, err := helper.ReadFromCsvFile[Data]("testdata/macd.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := helper.ChanToSlice(helper.Map(rows, func(d *Data) float64 { return d.Close }))
+
+	macd := trend.NewMacd[float64]()
+	expectedMacds, expectedSignals := macd.Compute(helper.SliceToChan(input))
+
+	var actualMacds, actualSignals []float64
+
+	for i, n := range input {
+		macdValue, signalValue, ok := macd.Update(n)
+		if ok != (i >= macd.IdlePeriod()) {
+			t.Fatalf("index %d actual %v expected %v", i, ok, !ok)
+		}
+
+		if ok {
+			actualMacds = append(actualMacds, macdValue)
+			actualSignals = append(actualSignals, signalValue)
+		}
+	}
+
+	err = helper.CheckEquals(
+		helper.SliceToChan(actualMacds), expectedMacds,
+		helper.SliceToChan(actualSignals), expectedSignals,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
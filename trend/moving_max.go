@@ -13,6 +13,12 @@ import "github.com/miromax42/indicator/v2/helper"
 type MovingMax[T helper.Number] struct {
 	// Time period.
 	Period int
+
+	// window is the window of values for the incremental updates.
+	window *helper.Ring[T]
+
+	// bst is the binary search tree for the incremental updates.
+	bst *helper.Bst[T]
 }
 
 // NewMovingMax function initializes a new Moving Max instance with the default parameters.
@@ -45,6 +51,24 @@ func (m *MovingMax[T]) Compute(c <-chan T) <-chan T {
 	return helper.Skip(maxs, m.Period-1)
 }
 
+// Update takes the next value and computes the Moving Max incrementally. It returns
+// false until the Moving Max yields its first result after the idle period.
+func (m *MovingMax[T]) Update(n T) (T, bool) {
+	if m.window == nil {
+		m.window = helper.NewRing[T](m.Period)
+		m.bst = helper.NewBst[T]()
+	}
+
+	m.bst.Insert(n)
+	m.bst.Remove(m.window.Put(n))
+
+	if !m.window.IsFull() {
+		return 0, false
+	}
+
+	return m.bst.Max(), true
+}
+
 // IdlePeriod is the initial period that Mocing Max won't yield any results.
 func (m *MovingMax[T]) IdlePeriod() int {
 	return m.Period - 1
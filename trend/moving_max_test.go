@@ -23,3 +23,37 @@ func TestMovingMax(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestMovingMaxUpdate(t *testing.T) {
+	type Data struct {
+		Close float64
+	}
+
+	rows, err := helper.ReadFromCsvFile[Data]("testdata/wma.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := helper.ChanToSlice(helper.Map(rows, func(d *Data) float64 { return d.Close }))
+
+	max := trend.NewMovingMaxWithPeriod[float64](4)
+	expected := max.Compute(helper.SliceToChan(input))
+
+	var actual []float64
+
+	for i, n := range input {
+		value, ok := max.Update(n)
+		if ok != (i >= max.IdlePeriod()) {
+			t.Fatalf("index %d actual %v expected %v", i, ok, !ok)
+		}
+
+		if ok {
+			actual = append(actual, value)
+		}
+	}
+
+	err = helper.CheckEquals(helper.SliceToChan(actual), expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -15,6 +15,12 @@ import "github.com/miromax42/indicator/v2/helper"
 type MovingSum[T helper.Number] struct {
 	// Time period.
 	Period int
+
+	// window is the window of values for the incremental updates.
+	window *helper.Ring[T]
+
+	// sum is the current sum for the incremental updates.
+	sum T
 }
 
 // NewMovingSum function initializes a new Moving Sum instance with the default parameters.
@@ -45,6 +51,22 @@ func (m *MovingSum[T]) Compute(c <-chan T) <-chan T {
 	return helper.Skip(sums, m.Period-1)
 }
 
+// Update takes the next value and computes the Moving Sum incrementally. It returns
+// false until the Moving Sum yields its first result after the idle period.
+func (m *MovingSum[T]) Update(n T) (T, bool) {
+	if m.window == nil {
+		m.window = helper.NewRing[T](m.Period)
+	}
+
+	m.sum = m.sum + n - m.window.Put(n)
+
+	if !m.window.IsFull() {
+		return 0, false
+	}
+
+	return m.sum, true
+}
+
 // IdlePeriod is the initial period that Moving Sum won't yield any results.
 func (m *MovingSum[T]) IdlePeriod() int {
 	return m.Period - 1
@@ -25,3 +25,37 @@ func TestMovingSum(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestMovingSumUpdate(t *testing.T) {
+	type Data struct {
+		Close float64
+	}
+
+	rows, err := helper.ReadFromCsvFile[Data]("testdata/wma.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := helper.ChanToSlice(helper.Map(rows, func(d *Data) float64 { return d.Close }))
+
+	sum := trend.NewMovingSumWithPeriod[float64](4)
+	expected := sum.Compute(helper.SliceToChan(input))
+
+	var actual []float64
+
+	for i, n := range input {
+		value, ok := sum.Update(n)
+		if ok != (i >= sum.IdlePeriod()) {
+			t.Fatalf("index %d actual %v expected %v", i, ok, !ok)
+		}
+
+		if ok {
+			actual = append(actual, value)
+		}
+	}
+
+	err = helper.CheckEquals(helper.SliceToChan(actual), expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
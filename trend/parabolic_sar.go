@@ -0,0 +1,148 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import "github.com/miromax42/indicator/v2/helper"
+
+const (
+	// DefaultParabolicSarStep is the default acceleration factor step of 0.02.
+	DefaultParabolicSarStep = 0.02
+
+	// DefaultParabolicSarMax is the default maximum acceleration factor of 0.20.
+	DefaultParabolicSarMax = 0.20
+)
+
+const (
+	// ParabolicSarFalling is the trend direction value for a falling trend.
+	ParabolicSarFalling = -1
+
+	// ParabolicSarRising is the trend direction value for a rising trend.
+	ParabolicSarRising = 1
+)
+
+// ParabolicSar represents the configuration parameters for calculating the Parabolic
+// SAR (Stop and Reverse). It trails the price as the trend extends, accelerating
+// towards the extreme point of the trend, and flips to the opposite side of the
+// price when the price crosses it, signaling a reversal of the trend.
+//
+//	SAR = Previous SAR + AF * (EP - Previous SAR)
+//
+// The Extreme Point (EP) is the highest high of a rising trend, or the lowest low of
+// a falling trend. The Acceleration Factor (AF) starts at the step value, and increases
+// by the step value each time a new extreme point is reached, up to the maximum value.
+// In a rising trend, SAR is never above the previous two lows, and in a falling trend,
+// it is never below the previous two highs. The initial trend is rising if the first
+// closing is at or above the midpoint of its range.
+//
+// Example:
+//
+//	psar := trend.NewParabolicSar[float64]()
+//	psar.Step = 0.02
+//	psar.Max = 0.20
+//
+//	sars, trends := psar.Compute(highs, lows, closings)
+type ParabolicSar[T helper.Number] struct {
+	// Step is the acceleration factor step.
+	Step T
+
+	// Max is the maximum acceleration factor.
+	Max T
+}
+
+// parabolicSarResult is the result of the Parabolic SAR at a single bar.
+type parabolicSarResult[T helper.Number] struct {
+	sar   T
+	trend T
+}
+
+// NewParabolicSar function initializes a new Parabolic SAR instance with the default parameters.
+func NewParabolicSar[T helper.Number]() *ParabolicSar[T] {
+	step := DefaultParabolicSarStep
+	max := DefaultParabolicSarMax
+
+	return NewParabolicSarWith(T(step), T(max))
+}
+
+// NewParabolicSarWith function initializes a new Parabolic SAR instance with the given
+// acceleration factor step and maximum.
+func NewParabolicSarWith[T helper.Number](step, max T) *ParabolicSar[T] {
+	return &ParabolicSar[T]{
+		Step: step,
+		Max:  max,
+	}
+}
+
+// Compute function takes channels of highs, lows, and closings, and computes the
+// Parabolic SAR and the trend direction, ParabolicSarRising or ParabolicSarFalling,
+// for each of them.
+func (p *ParabolicSar[T]) Compute(highs, lows, closings <-chan T) (<-chan T, <-chan T) {
+	first := true
+
+	var sar, ep, af T
+	var trend T
+	var previousHighs, previousLows [2]T
+
+	results := helper.Operate3(highs, lows, closings, func(high, low, closing T) *parabolicSarResult[T] {
+		if first {
+			first = false
+			af = p.Step
+			previousHighs = [2]T{high, high}
+			previousLows = [2]T{low, low}
+
+			if closing*2 >= high+low {
+				trend = ParabolicSarRising
+				sar = low
+				ep = high
+			} else {
+				trend = ParabolicSarFalling
+				sar = high
+				ep = low
+			}
+
+			return &parabolicSarResult[T]{sar: sar, trend: trend}
+		}
+
+		// SAR = Previous SAR + AF * (EP - Previous SAR)
+		sar += af * (ep - sar)
+
+		if trend == ParabolicSarRising {
+			sar = min(sar, previousLows[0], previousLows[1])
+
+			if low <= sar {
+				trend = ParabolicSarFalling
+				sar = ep
+				ep = low
+				af = p.Step
+			} else if high > ep {
+				ep = high
+				af = min(af+p.Step, p.Max)
+			}
+		} else {
+			sar = max(sar, previousHighs[0], previousHighs[1])
+
+			if high >= sar {
+				trend = ParabolicSarRising
+				sar = ep
+				ep = high
+				af = p.Step
+			} else if low < ep {
+				ep = low
+				af = min(af+p.Step, p.Max)
+			}
+		}
+
+		previousHighs = [2]T{high, previousHighs[0]}
+		previousLows = [2]T{low, previousLows[0]}
+
+		return &parabolicSarResult[T]{sar: sar, trend: trend}
+	})
+
+	resultsSplice := helper.Duplicate(results, 2)
+
+	sars := helper.Map(resultsSplice[0], func(r *parabolicSarResult[T]) T { return r.sar })
+	trends := helper.Map(resultsSplice[1], func(r *parabolicSarResult[T]) T { return r.trend })
+
+	return sars, trends
+}
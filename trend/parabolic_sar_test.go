@@ -0,0 +1,43 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend_test
+
+import (
+	"testing"
+
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/trend"
+)
+
+func TestParabolicSar(t *testing.T) {
+	type ParabolicSarData struct {
+		High  float64
+		Low   float64
+		Close float64
+		Sar   float64
+		Trend float64
+	}
+
+	input, err := helper.ReadFromCsvFile[ParabolicSarData]("testdata/parabolic_sar.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 5)
+	highs := helper.Map(inputs[0], func(d *ParabolicSarData) float64 { return d.High })
+	lows := helper.Map(inputs[1], func(d *ParabolicSarData) float64 { return d.Low })
+	closings := helper.Map(inputs[2], func(d *ParabolicSarData) float64 { return d.Close })
+	expectedSars := helper.Map(inputs[3], func(d *ParabolicSarData) float64 { return d.Sar })
+	expectedTrends := helper.Map(inputs[4], func(d *ParabolicSarData) float64 { return d.Trend })
+
+	psar := trend.NewParabolicSar[float64]()
+	actualSars, actualTrends := psar.Compute(highs, lows, closings)
+	actualSars = helper.RoundDigits(actualSars, 2)
+
+	err = helper.CheckEquals(actualSars, expectedSars, actualTrends, expectedTrends)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,272 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"math"
+
+	"github.com/miromax42/indicator/v2/helper"
+)
+
+// PivotPointMethod is the method for calculating the pivot point levels.
+type PivotPointMethod int
+
+const (
+	// PivotPointClassic is the classic pivot point method.
+	PivotPointClassic PivotPointMethod = iota
+
+	// PivotPointFibonacci is the Fibonacci pivot point method.
+	PivotPointFibonacci
+
+	// PivotPointWoodie is the Woodie pivot point method.
+	PivotPointWoodie
+
+	// PivotPointCamarilla is the Camarilla pivot point method.
+	PivotPointCamarilla
+
+	// PivotPointDemark is the DeMark pivot point method.
+	PivotPointDemark
+)
+
+const (
+	// DefaultPivotPointBars is the default number of bars in a pivot point period.
+	DefaultPivotPointBars = 1
+)
+
+// String returns the name of the pivot point method.
+func (m PivotPointMethod) String() string {
+	switch m {
+	case PivotPointFibonacci:
+		return "Fibonacci"
+
+	case PivotPointWoodie:
+		return "Woodie"
+
+	case PivotPointCamarilla:
+		return "Camarilla"
+
+	case PivotPointDemark:
+		return "DeMark"
+
+	default:
+		return "Classic"
+	}
+}
+
+// PivotPoint represents the configuration parameters for calculating the pivot points. The pivot
+// point and its support and resistance levels are calculated from the open, high, low, and close of
+// the prior period, and they stay the same until the end of the current period. A period consists of
+// the given number of bars, counted from the first bar, rather than a calendar period. To compute the
+// pivot points of calendar periods, such as the daily pivot points of the intraday bars, the bars of a
+// period should either be resampled into a single bar with the asset.Resampler, or counted exactly,
+// without any missing bars. Returns the pivot point, the resistance levels R1, R2, R3, and the support
+// levels S1, S2, S3.
+//
+// Classic:
+//
+//	P = (High + Low + Close) / 3
+//	R1 = 2 * P - Low, S1 = 2 * P - High
+//	R2 = P + (High - Low), S2 = P - (High - Low)
+//	R3 = High + 2 * (P - Low), S3 = Low - 2 * (High - P)
+//
+// Fibonacci:
+//
+//	P = (High + Low + Close) / 3
+//	R1 = P + 0.382 * (High - Low), S1 = P - 0.382 * (High - Low)
+//	R2 = P + 0.618 * (High - Low), S2 = P - 0.618 * (High - Low)
+//	R3 = P + (High - Low), S3 = P - (High - Low)
+//
+// Woodie:
+//
+//	P = (High + Low + 2 * Close) / 4
+//	R1, R2, R3, S1, S2, S3 like classic.
+//
+// Camarilla:
+//
+//	P = (High + Low + Close) / 3
+//	R1 = Close + 1.1 * (High - Low) / 12, S1 = Close - 1.1 * (High - Low) / 12
+//	R2 = Close + 1.1 * (High - Low) / 6, S2 = Close - 1.1 * (High - Low) / 6
+//	R3 = Close + 1.1 * (High - Low) / 4, S3 = Close - 1.1 * (High - Low) / 4
+//
+// DeMark:
+//
+//	X = High + 2 * Low + Close if Close < Open
+//	X = 2 * High + Low + Close if Close > Open
+//	X = High + Low + 2 * Close if Close = Open
+//	P = X / 4
+//	R1 = X / 2 - Low, S1 = X / 2 - High
+//
+// DeMark only defines the first levels, so R2 and R3 are the same as R1, and S2 and S3 are the same as S1.
+//
+// Example:
+//
+//	pivotPoint := trend.NewPivotPoint[float64]()
+//	p, r1, r2, r3, s1, s2, s3 := pivotPoint.Compute(openings, highs, lows, closings)
+type PivotPoint[T helper.Number] struct {
+	// Method is the pivot point method.
+	Method PivotPointMethod
+
+	// Bars is the number of bars in a period.
+	Bars int
+}
+
+// pivotPointBar is the open, high, low, and close of a bar or a period.
+type pivotPointBar struct {
+	open  float64
+	high  float64
+	low   float64
+	close float64
+}
+
+// pivotPointLevels is the pivot point and its resistance and support levels.
+type pivotPointLevels[T helper.Number] struct {
+	p  T
+	r1 T
+	r2 T
+	r3 T
+	s1 T
+	s2 T
+	s3 T
+}
+
+// NewPivotPoint function initializes a new pivot point instance with the default parameters.
+func NewPivotPoint[T helper.Number]() *PivotPoint[T] {
+	return NewPivotPointWith[T](PivotPointClassic, DefaultPivotPointBars)
+}
+
+// NewPivotPointWith function initializes a new pivot point instance with the given method and number
+// of bars in a period.
+func NewPivotPointWith[T helper.Number](method PivotPointMethod, bars int) *PivotPoint[T] {
+	return &PivotPoint[T]{
+		Method: method,
+		Bars:   bars,
+	}
+}
+
+// Compute function takes channels of openings, highs, lows, and closings, and computes the pivot point,
+// the resistance levels R1, R2, R3, and the support levels S1, S2, S3.
+func (p *PivotPoint[T]) Compute(openings, highs, lows, closings <-chan T) (
+	<-chan T, <-chan T, <-chan T, <-chan T, <-chan T, <-chan T, <-chan T,
+) {
+	bars := helper.Operate(
+		openings,
+		helper.Operate3(highs, lows, closings, func(high, low, closing T) *pivotPointBar {
+			return &pivotPointBar{
+				high:  float64(high),
+				low:   float64(low),
+				close: float64(closing),
+			}
+		}),
+		func(opening T, bar *pivotPointBar) *pivotPointBar {
+			bar.open = float64(opening)
+			return bar
+		},
+	)
+
+	var period *pivotPointBar
+	var levels *pivotPointLevels[T]
+	count := 0
+
+	levelsSplice := helper.Duplicate(
+		helper.Skip(
+			helper.Map(bars, func(bar *pivotPointBar) *pivotPointLevels[T] {
+				if count == p.Bars {
+					levels = p.levels(period)
+					count = 0
+				}
+
+				if count == 0 {
+					period = bar
+				} else {
+					period.high = math.Max(period.high, bar.high)
+					period.low = math.Min(period.low, bar.low)
+					period.close = bar.close
+				}
+
+				count++
+
+				return levels
+			}),
+			p.IdlePeriod(),
+		),
+		7,
+	)
+
+	return helper.Map(levelsSplice[0], func(l *pivotPointLevels[T]) T { return l.p }),
+		helper.Map(levelsSplice[1], func(l *pivotPointLevels[T]) T { return l.r1 }),
+		helper.Map(levelsSplice[2], func(l *pivotPointLevels[T]) T { return l.r2 }),
+		helper.Map(levelsSplice[3], func(l *pivotPointLevels[T]) T { return l.r3 }),
+		helper.Map(levelsSplice[4], func(l *pivotPointLevels[T]) T { return l.s1 }),
+		helper.Map(levelsSplice[5], func(l *pivotPointLevels[T]) T { return l.s2 }),
+		helper.Map(levelsSplice[6], func(l *pivotPointLevels[T]) T { return l.s3 })
+}
+
+// IdlePeriod is the initial period that pivot point won't yield any results.
+func (p *PivotPoint[T]) IdlePeriod() int {
+	return p.Bars
+}
+
+// levels calculates the pivot point levels from the given prior period.
+func (p *PivotPoint[T]) levels(period *pivotPointBar) *pivotPointLevels[T] {
+	high, low, closing := period.high, period.low, period.close
+	length := high - low
+
+	var pp, r1, r2, r3, s1, s2, s3 float64
+
+	switch p.Method {
+	case PivotPointFibonacci:
+		pp = (high + low + closing) / 3
+		r1, s1 = pp+0.382*length, pp-0.382*length
+		r2, s2 = pp+0.618*length, pp-0.618*length
+		r3, s3 = pp+length, pp-length
+
+	case PivotPointCamarilla:
+		pp = (high + low + closing) / 3
+		r1, s1 = closing+1.1*length/12, closing-1.1*length/12
+		r2, s2 = closing+1.1*length/6, closing-1.1*length/6
+		r3, s3 = closing+1.1*length/4, closing-1.1*length/4
+
+	case PivotPointDemark:
+		var x float64
+
+		switch {
+		case closing < period.open:
+			x = high + 2*low + closing
+
+		case closing > period.open:
+			x = 2*high + low + closing
+
+		default:
+			x = high + low + 2*closing
+		}
+
+		pp = x / 4
+		r1, s1 = x/2-low, x/2-high
+		r2, s2 = r1, s1
+		r3, s3 = r1, s1
+
+	case PivotPointWoodie:
+		pp = (high + low + 2*closing) / 4
+		r1, s1 = 2*pp-low, 2*pp-high
+		r2, s2 = pp+length, pp-length
+		r3, s3 = high+2*(pp-low), low-2*(high-pp)
+
+	default:
+		pp = (high + low + closing) / 3
+		r1, s1 = 2*pp-low, 2*pp-high
+		r2, s2 = pp+length, pp-length
+		r3, s3 = high+2*(pp-low), low-2*(high-pp)
+	}
+
+	return &pivotPointLevels[T]{
+		p:  T(pp),
+		r1: T(r1),
+		r2: T(r2),
+		r3: T(r3),
+		s1: T(s1),
+		s2: T(s2),
+		s3: T(s3),
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend_test
+
+import (
+	"testing"
+
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/trend"
+)
+
+func TestPivotPoint(t *testing.T) {
+	type Data struct {
+		Open  float64
+		High  float64
+		Low   float64
+		Close float64
+		P     float64
+		R1    float64
+		R2    float64
+		R3    float64
+		S1    float64
+		S2    float64
+		S3    float64
+	}
+
+	tests := []struct {
+		fileName   string
+		pivotPoint *trend.PivotPoint[float64]
+	}{
+		{"testdata/pivot_point_classic.csv", trend.NewPivotPoint[float64]()},
+		{"testdata/pivot_point_fibonacci.csv", trend.NewPivotPointWith[float64](trend.PivotPointFibonacci, 5)},
+		{"testdata/pivot_point_woodie.csv", trend.NewPivotPointWith[float64](trend.PivotPointWoodie, 5)},
+		{"testdata/pivot_point_camarilla.csv", trend.NewPivotPointWith[float64](trend.PivotPointCamarilla, 5)},
+		{"testdata/pivot_point_demark.csv", trend.NewPivotPointWith[float64](trend.PivotPointDemark, 5)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.pivotPoint.Method.String(), func(t *testing.T) {
+			input, err := helper.ReadFromCsvFile[Data](test.fileName, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			inputs := helper.Duplicate(input, 11)
+			openings := helper.Map(inputs[0], func(d *Data) float64 { return d.Open })
+			highs := helper.Map(inputs[1], func(d *Data) float64 { return d.High })
+			lows := helper.Map(inputs[2], func(d *Data) float64 { return d.Low })
+			closings := helper.Map(inputs[3], func(d *Data) float64 { return d.Close })
+
+			expected := []<-chan float64{
+				helper.Map(inputs[4], func(d *Data) float64 { return d.P }),
+				helper.Map(inputs[5], func(d *Data) float64 { return d.R1 }),
+				helper.Map(inputs[6], func(d *Data) float64 { return d.R2 }),
+				helper.Map(inputs[7], func(d *Data) float64 { return d.R3 }),
+				helper.Map(inputs[8], func(d *Data) float64 { return d.S1 }),
+				helper.Map(inputs[9], func(d *Data) float64 { return d.S2 }),
+				helper.Map(inputs[10], func(d *Data) float64 { return d.S3 }),
+			}
+
+			p, r1, r2, r3, s1, s2, s3 := test.pivotPoint.Compute(openings, highs, lows, closings)
+			actual := []<-chan float64{p, r1, r2, r3, s1, s2, s3}
+
+			pairs := make([]<-chan float64, 0, 2*len(actual))
+			for i := range actual {
+				pairs = append(pairs,
+					helper.RoundDigits(actual[i], 2),
+					helper.Skip(expected[i], test.pivotPoint.IdlePeriod()),
+				)
+			}
+
+			err = helper.CheckEquals(pairs...)
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -25,6 +25,15 @@ const (
 type Rma[T helper.Number] struct {
 	// Time period.
 	Period int
+
+	// sma is the SMA for the initial value of the incremental updates.
+	sma *Sma[T]
+
+	// before is the last RMA value of the incremental updates.
+	before T
+
+	// ready indicates whether the incremental updates passed the idle period.
+	ready bool
 }
 
 // NewRma function initializes a new RMA instance with the default parameters.
@@ -62,6 +71,30 @@ func (r *Rma[T]) Compute(c <-chan T) <-chan T {
 	return result
 }
 
+// Update takes the next value and computes the RMA incrementally. It returns
+// false until the RMA yields its first result after the idle period.
+func (r *Rma[T]) Update(n T) (T, bool) {
+	if r.ready {
+		r.before = ((r.before * T(r.Period-1)) + n) / T(r.Period)
+		return r.before, true
+	}
+
+	// Initial RMA value is the SMA.
+	if r.sma == nil {
+		r.sma = NewSmaWithPeriod[T](r.Period)
+	}
+
+	before, ok := r.sma.Update(n)
+	if !ok {
+		return 0, false
+	}
+
+	r.before = before
+	r.ready = true
+
+	return r.before, true
+}
+
 // IdlePeriod is the initial period that RMA won't yield any results.
 func (r *Rma[T]) IdlePeriod() int {
 	return r.Period - 1
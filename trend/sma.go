@@ -26,6 +26,9 @@ const (
 type Sma[T helper.Number] struct {
 	// Period is the time period for the SMA.
 	Period int
+
+	// sum is the moving sum for the incremental updates.
+	sum *MovingSum[T]
 }
 
 // NewSma function initializes a new SMA instance with the default parameters.
@@ -50,6 +53,21 @@ func (s *Sma[T]) Compute(c <-chan T) <-chan T {
 	})
 }
 
+// Update takes the next value and computes the SMA incrementally. It returns
+// false until the SMA yields its first result after the idle period.
+func (s *Sma[T]) Update(n T) (T, bool) {
+	if s.sum == nil {
+		s.sum = NewMovingSumWithPeriod[T](s.Period)
+	}
+
+	sum, ok := s.sum.Update(n)
+	if !ok {
+		return 0, false
+	}
+
+	return sum / T(s.Period), true
+}
+
 // IdlePeriod is the initial period that SMA won't yield any results.
 func (s *Sma[T]) IdlePeriod() int {
 	return s.Period - 1
@@ -0,0 +1,120 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"math"
+
+	"github.com/miromax42/indicator/v2/helper"
+)
+
+const (
+	// DefaultVortexPeriod is the default period for the Vortex Indicator.
+	DefaultVortexPeriod = 14
+)
+
+// Vortex represents the configuration parameters for calculating the Vortex Indicator. It
+// consists of two lines, the positive vortex line (VI+) and the negative vortex line (VI-),
+// capturing the upward and downward trend movements. When VI+ crosses above VI-, it suggests
+// the start of an uptrend; when VI- crosses above VI+, it suggests the start of a downtrend.
+//
+//	+VM = Abs(High - Previous Low)
+//	-VM = Abs(Low - Previous High)
+//	TR = Max((High - Low), (High - Previous Closing), (Previous Closing - Low))
+//	VI+ = Sum(+VM, Period) / Sum(TR, Period)
+//	VI- = Sum(-VM, Period) / Sum(TR, Period)
+//
+// Example:
+//
+//	vortex := trend.NewVortex[float64]()
+//	vortex.Period = 14
+//
+//	plus, minus := vortex.Compute(highs, lows, closings)
+type Vortex[T helper.Number] struct {
+	// Period is the period to use.
+	Period int
+}
+
+// vortexMovement is the vortex movements and the true range at a single bar.
+type vortexMovement[T helper.Number] struct {
+	plus  T
+	minus T
+	tr    T
+}
+
+// NewVortex function initializes a new Vortex Indicator instance with the default parameters.
+func NewVortex[T helper.Number]() *Vortex[T] {
+	return NewVortexWithPeriod[T](DefaultVortexPeriod)
+}
+
+// NewVortexWithPeriod function initializes a new Vortex Indicator instance with the given period.
+func NewVortexWithPeriod[T helper.Number](period int) *Vortex[T] {
+	return &Vortex[T]{
+		Period: period,
+	}
+}
+
+// Compute function takes channels of highs, lows, and closings, and computes the
+// positive and negative vortex lines over the specified period.
+func (v *Vortex[T]) Compute(highs, lows, closings <-chan T) (<-chan T, <-chan T) {
+	first := true
+	var previousHigh, previousLow, previousClosing T
+
+	movements := helper.Operate3(highs, lows, closings, func(high, low, closing T) *vortexMovement[T] {
+		movement := &vortexMovement[T]{
+			// +VM = Abs(High - Previous Low)
+			plus: T(math.Abs(float64(high - previousLow))),
+
+			// -VM = Abs(Low - Previous High)
+			minus: T(math.Abs(float64(low - previousHigh))),
+
+			// TR = Max((High - Low), (High - Previous Closing), (Previous Closing - Low))
+			tr: T(math.Max(float64(high-low), math.Max(float64(high-previousClosing), float64(previousClosing-low)))),
+		}
+
+		if first {
+			first = false
+			movement = nil
+		}
+
+		previousHigh = high
+		previousLow = low
+		previousClosing = closing
+
+		return movement
+	})
+
+	// Use the previous values by skipping the first movement.
+	movementsSplice := helper.Duplicate(helper.Skip(movements, 1), 3)
+
+	plusSums := NewMovingSumWithPeriod[T](v.Period).Compute(
+		helper.Map(movementsSplice[0], func(m *vortexMovement[T]) T { return m.plus }),
+	)
+
+	minusSums := NewMovingSumWithPeriod[T](v.Period).Compute(
+		helper.Map(movementsSplice[1], func(m *vortexMovement[T]) T { return m.minus }),
+	)
+
+	trSumsSplice := helper.Duplicate(
+		NewMovingSumWithPeriod[T](v.Period).Compute(
+			helper.Map(movementsSplice[2], func(m *vortexMovement[T]) T { return m.tr }),
+		),
+		2,
+	)
+
+	// VI+ = Sum(+VM, Period) / Sum(TR, Period)
+	plus := helper.Divide(plusSums, trSumsSplice[0])
+
+	// VI- = Sum(-VM, Period) / Sum(TR, Period)
+	minus := helper.Divide(minusSums, trSumsSplice[1])
+
+	return plus, minus
+}
+
+// IdlePeriod is the initial period that Vortex Indicator won't yield any results.
+func (v *Vortex[T]) IdlePeriod() int {
+	// Moving sum idle period and for using the previous values.
+	return v.Period
+}
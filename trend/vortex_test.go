@@ -0,0 +1,48 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend_test
+
+import (
+	"testing"
+
+	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/trend"
+)
+
+func TestVortex(t *testing.T) {
+	type VortexData struct {
+		High  float64
+		Low   float64
+		Close float64
+		Plus  float64
+		Minus float64
+	}
+
+	input, err := helper.ReadFromCsvFile[VortexData]("testdata/vortex.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vortex := trend.NewVortex[float64]()
+
+	inputs := helper.Duplicate(input, 5)
+	highs := helper.Map(inputs[0], func(d *VortexData) float64 { return d.High })
+	lows := helper.Map(inputs[1], func(d *VortexData) float64 { return d.Low })
+	closings := helper.Map(inputs[2], func(d *VortexData) float64 { return d.Close })
+	expectedPlus := helper.Map(inputs[3], func(d *VortexData) float64 { return d.Plus })
+	expectedMinus := helper.Map(inputs[4], func(d *VortexData) float64 { return d.Minus })
+
+	expectedPlus = helper.Skip(expectedPlus, vortex.IdlePeriod())
+	expectedMinus = helper.Skip(expectedMinus, vortex.IdlePeriod())
+
+	actualPlus, actualMinus := vortex.Compute(highs, lows, closings)
+	actualPlus = helper.RoundDigits(actualPlus, 2)
+	actualMinus = helper.RoundDigits(actualMinus, 2)
+
+	err = helper.CheckEquals(actualPlus, expectedPlus, actualMinus, expectedMinus)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -17,6 +17,9 @@ import (
 type Wma[T helper.Number] struct {
 	// Time period.
 	Period int
+
+	// window is the window of values for the incremental updates.
+	window *helper.Ring[T]
 }
 
 // NewWmaWith function initializes a new WMA instance with the given parameters.
@@ -37,13 +40,7 @@ func (w *Wma[T]) Compute(values <-chan T) <-chan T {
 			return T(0)
 		}
 
-		var sum T
-
-		for i := 0; i < w.Period; i++ {
-			sum += window.At(i) * T(i+1) / T(w.Period)
-		}
-
-		return sum / 2
+		return w.weightedSum(window)
 	})
 
 	wmas = helper.Skip(wmas, w.IdlePeriod())
@@ -51,6 +48,22 @@ func (w *Wma[T]) Compute(values <-chan T) <-chan T {
 	return wmas
 }
 
+// Update takes the next value and computes the WMA incrementally. It returns
+// false until the WMA yields its first result after the idle period.
+func (w *Wma[T]) Update(value T) (T, bool) {
+	if w.window == nil {
+		w.window = helper.NewRing[T](w.Period)
+	}
+
+	w.window.Put(value)
+
+	if !w.window.IsFull() {
+		return 0, false
+	}
+
+	return w.weightedSum(w.window), true
+}
+
 // IdlePeriod is the initial period that WMA won't yield any results.
 func (w *Wma[T]) IdlePeriod() int {
 	return w.Period - 1
@@ -60,3 +73,14 @@ func (w *Wma[T]) IdlePeriod() int {
 func (w *Wma[T]) String() string {
 	return fmt.Sprintf("WMA(%d)", w.Period)
 }
+
+// weightedSum computes the WMA for the values in the given full window.
+func (w *Wma[T]) weightedSum(window *helper.Ring[T]) T {
+	var sum T
+
+	for i := 0; i < w.Period; i++ {
+		sum += window.At(i) * T(i+1) / T(w.Period)
+	}
+
+	return sum / 2
+}
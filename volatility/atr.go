@@ -32,6 +32,12 @@ const (
 type Atr[T helper.Number] struct {
 	// Ma is the moving average for the ATR.
 	Ma trend.Ma[T]
+
+	// before is the previous closing for the incremental updates.
+	before T
+
+	// started indicates whether the incremental updates have started.
+	started bool
 }
 
 // NewAtr function initializes a new ATR instance with the default parameters.
@@ -66,6 +72,31 @@ func (a *Atr[T]) Compute(highs, lows, closings <-chan T) <-chan T {
 	return atr
 }
 
+// Update takes the next high, low, and closing values, and computes the ATR
+// incrementally. It returns false until the ATR yields its first result after
+// the idle period. As the ATR can only be updated through a moving average that
+// implements the trend.IncrementalMa interface, Update always returns false for
+// the other ones, such as HMA and KAMA.
+func (a *Atr[T]) Update(high, low, closing T) (T, bool) {
+	ma, ok := a.Ma.(trend.IncrementalMa[T])
+	if !ok {
+		return 0, false
+	}
+
+	// Use previous closing.
+	if !a.started {
+		a.before = closing
+		a.started = true
+
+		return 0, false
+	}
+
+	tr := T(math.Max(float64(high-low), math.Max(float64(high-a.before), float64(a.before-low))))
+	a.before = closing
+
+	return ma.Update(tr)
+}
+
 // IdlePeriod is the initial period that Acceleration Bands won't yield any results.
 func (a *Atr[T]) IdlePeriod() int {
 	// Ma idle period and for using the previous closing.
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/miromax42/indicator/v2/helper"
+	"github.com/miromax42/indicator/v2/trend"
 	"github.com/miromax42/indicator/v2/volatility"
 )
 
@@ -41,3 +42,54 @@ func TestAtr(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestAtrUpdate(t *testing.T) {
+	type Data struct {
+		High  float64
+		Low   float64
+		Close float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/atr.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows := helper.ChanToSlice(input)
+	inputs := helper.Duplicate(helper.SliceToChan(rows), 3)
+	highs := helper.Map(inputs[0], func(d *Data) float64 { return d.High })
+	lows := helper.Map(inputs[1], func(d *Data) float64 { return d.Low })
+	closings := helper.Map(inputs[2], func(d *Data) float64 { return d.Close })
+
+	atr := volatility.NewAtrWithPeriod[float64](50)
+	expected := atr.Compute(highs, lows, closings)
+
+	var actual []float64
+
+	for i, row := range rows {
+		value, ok := atr.Update(row.High, row.Low, row.Close)
+		if ok != (i >= atr.IdlePeriod()) {
+			t.Fatalf("index %d actual %v expected %v", i, ok, !ok)
+		}
+
+		if ok {
+			actual = append(actual, value)
+		}
+	}
+
+	err = helper.CheckEquals(helper.SliceToChan(actual), expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAtrUpdateNotIncremental(t *testing.T) {
+	atr := volatility.NewAtrWithMa[float64](trend.NewHmaWithPeriod[float64](2))
+
+	for i := 0; i < 10; i++ {
+		_, ok := atr.Update(2, 1, 1.5)
+		if ok {
+			t.Fatalf("index %d actual %v expected %v", i, ok, false)
+		}
+	}
+}
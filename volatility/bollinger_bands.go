@@ -29,6 +29,12 @@ const (
 type BollingerBands[T helper.Number] struct {
 	// Time period.
 	Period int
+
+	// sma is the SMA for the incremental updates.
+	sma *trend.Sma[T]
+
+	// std is the moving standard deviation for the incremental updates.
+	std *MovingStd[T]
 }
 
 // NewBollingerBands function initializes a new Bollinger Bands instance with the default parameters.
@@ -70,6 +76,27 @@ func (b *BollingerBands[T]) Compute(c <-chan T) (<-chan T, <-chan T, <-chan T) {
 	return upperBand, middleBands[2], lowerBand
 }
 
+// Update takes the next value and computes the upper band, the middle band, and
+// the lower band incrementally. It returns false until the Bollinger Bands yield
+// their first result after the idle period.
+func (b *BollingerBands[T]) Update(n T) (T, T, T, bool) {
+	if b.sma == nil {
+		b.sma = trend.NewSmaWithPeriod[T](b.Period)
+		b.std = NewMovingStdWithPeriod[T](b.Period)
+	}
+
+	middle, _ := b.sma.Update(n)
+
+	std, ok := b.std.Update(n)
+	if !ok {
+		return 0, 0, 0, false
+	}
+
+	std2 := std * 2
+
+	return middle + std2, middle, middle - std2, true
+}
+
 // IdlePeriod is the initial period that Bollinger Bands won't yield any results.
 func (b *BollingerBands[T]) IdlePeriod() int {
 	return b.Period - 1
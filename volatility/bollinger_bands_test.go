@@ -45,3 +45,43 @@ func TestBollingerBands(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestBollingerBandsUpdate(t *testing.T) {
+	type Data struct {
+		Close float64
+	}
+
+	rows, err := helper.ReadFromCsvFile[Data]("testdata/bollinger_bands.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := helper.ChanToSlice(helper.Map(rows, func(d *Data) float64 { return d.Close }))
+
+	bb := volatility.NewBollingerBands[float64]()
+	expectedUpper, expectedMiddle, expectedLower := bb.Compute(helper.SliceToChan(input))
+
+	var actualUpper, actualMiddle, actualLower []float64
+
+	for i, n := range input {
+		upper, middle, lower, ok := bb.Update(n)
+		if ok != (i >= bb.IdlePeriod()) {
+			t.Fatalf("index %d actual %v expected %v", i, ok, !ok)
+		}
+
+		if ok {
+			actualUpper = append(actualUpper, upper)
+			actualMiddle = append(actualMiddle, middle)
+			actualLower = append(actualLower, lower)
+		}
+	}
+
+	err = helper.CheckEquals(
+		helper.SliceToChan(actualUpper), expectedUpper,
+		helper.SliceToChan(actualMiddle), expectedMiddle,
+		helper.SliceToChan(actualLower), expectedLower,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
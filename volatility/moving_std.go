@@ -17,6 +17,12 @@ import (
 type MovingStd[T helper.Number] struct {
 	// Time period.
 	Period int
+
+	// window is the window of values for the incremental updates.
+	window *helper.Ring[T]
+
+	// sum is the current sum for the incremental updates.
+	sum T
 }
 
 // NewMovingStd function initializes a new Moving Standard Deviation instance with the default parameters.
@@ -35,7 +41,6 @@ func NewMovingStdWithPeriod[T helper.Number](period int) *MovingStd[T] {
 func (m *MovingStd[T]) Compute(c <-chan T) <-chan T {
 	result := make(chan T, cap(c))
 
-	//	Std = Sqrt(1/Period * Sum(Pow(value - sma), 2))
 	go func() {
 		defer close(result)
 
@@ -47,15 +52,7 @@ func (m *MovingStd[T]) Compute(c <-chan T) <-chan T {
 			sum += n
 
 			if ring.IsFull() {
-				sma := sum / T(m.Period)
-				sum2 := T(0)
-
-				for i := 0; i < m.Period; i++ {
-					sum2 += T(math.Pow(float64(ring.At(i)-sma), 2))
-				}
-
-				std := T(math.Sqrt(float64(sum2 / T(m.Period))))
-				result <- std
+				result <- m.std(ring, sum)
 			}
 		}
 	}()
@@ -63,7 +60,39 @@ func (m *MovingStd[T]) Compute(c <-chan T) <-chan T {
 	return result
 }
 
+// Update takes the next value and computes the Moving Standard Deviation incrementally.
+// It returns false until the Moving Standard Deviation yields its first result after
+// the idle period.
+func (m *MovingStd[T]) Update(n T) (T, bool) {
+	if m.window == nil {
+		m.window = helper.NewRing[T](m.Period)
+	}
+
+	m.sum -= m.window.Put(n)
+	m.sum += n
+
+	if !m.window.IsFull() {
+		return 0, false
+	}
+
+	return m.std(m.window, m.sum), true
+}
+
 // IdlePeriod is the initial period that Moving Standard Deviation won't yield any results.
 func (m *MovingStd[T]) IdlePeriod() int {
 	return m.Period - 1
 }
+
+// std computes the standard deviation for the values in the given full window with the given sum.
+//
+//	Std = Sqrt(1/Period * Sum(Pow(value - sma), 2))
+func (m *MovingStd[T]) std(window *helper.Ring[T], sum T) T {
+	sma := sum / T(m.Period)
+	sum2 := T(0)
+
+	for i := 0; i < m.Period; i++ {
+		sum2 += T(math.Pow(float64(window.At(i)-sma), 2))
+	}
+
+	return T(math.Sqrt(float64(sum2 / T(m.Period))))
+}
@@ -110,6 +110,11 @@ func (p *Po[T]) Compute(highs, lows, closings <-chan T) <-chan T {
 	return po
 }
 
+// Period returns the moving window period of the PO.
+func (p *Po[T]) Period() int {
+	return p.max.Period
+}
+
 // IdlePeriod is the initial period that PO won't yield any results.
 func (p *Po[T]) IdlePeriod() int {
 	return p.mls.IdlePeriod() + p.min.IdlePeriod()
@@ -18,7 +18,13 @@ import "github.com/miromax42/indicator/v2/helper"
 //
 //	obv := volume.NewObv[float64]()
 //	result := obv.Compute(closings, volumes)
-type Obv[T helper.Number] struct{}
+type Obv[T helper.Number] struct {
+	// previous is the previous OBV for the incremental updates.
+	previous T
+
+	// before is the previous closing for the incremental updates.
+	before T
+}
 
 // NewObv function initializes a new OBV instance with the default parameters.
 func NewObv[T helper.Number]() *Obv[T] {
@@ -28,21 +34,41 @@ func NewObv[T helper.Number]() *Obv[T] {
 // Compute function takes a channel of numbers and computes the OBV.
 func (*Obv[T]) Compute(closings, volumes <-chan T) <-chan T {
 	var previous T
+	var before T
 
 	return helper.Operate(closings, volumes, func(closing, volume T) T {
 		current := previous
 
-		if closing > previous {
+		if closing > before {
 			current += volume
-		} else if closing < previous {
+		} else if closing < before {
 			current -= volume
 		}
 
 		previous = current
+		before = closing
+
 		return current
 	})
 }
 
+// Update takes the next closing and volume values, and computes the OBV incrementally.
+// As OBV has no idle period, it always returns true.
+func (o *Obv[T]) Update(closing, volume T) (T, bool) {
+	current := o.previous
+
+	if closing > o.before {
+		current += volume
+	} else if closing < o.before {
+		current -= volume
+	}
+
+	o.previous = current
+	o.before = closing
+
+	return current, true
+}
+
 // IdlePeriod is the initial period that OBV won't yield any results.
 func (*Obv[T]) IdlePeriod() int {
 	return 0
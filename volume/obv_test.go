@@ -37,3 +37,41 @@ func TestObv(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestObvUpdate(t *testing.T) {
+	type ObvData struct {
+		Close  float64
+		Volume int64
+	}
+
+	input, err := helper.ReadFromCsvFile[ObvData]("testdata/obv.csv", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows := helper.ChanToSlice(input)
+	inputs := helper.Duplicate(helper.SliceToChan(rows), 2)
+	closings := helper.Map(inputs[0], func(m *ObvData) float64 { return m.Close })
+	volumes := helper.Map(inputs[1], func(m *ObvData) float64 { return float64(m.Volume) })
+
+	obv := volume.NewObv[float64]()
+	expected := obv.Compute(closings, volumes)
+
+	var actual []float64
+
+	for i, row := range rows {
+		value, ok := obv.Update(row.Close, float64(row.Volume))
+		if ok != (i >= obv.IdlePeriod()) {
+			t.Fatalf("index %d actual %v expected %v", i, ok, !ok)
+		}
+
+		if ok {
+			actual = append(actual, value)
+		}
+	}
+
+	err = helper.CheckEquals(helper.SliceToChan(actual), expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}